@@ -0,0 +1,39 @@
+package theme
+
+import "strings"
+
+// Direction defines the direction in which text within a table column is read
+type Direction int
+
+const (
+	// LeftToRight should be used for columns containing text that is read from
+	// left to right, such as English. This is the default direction of a column
+	LeftToRight Direction = iota
+
+	// RightToLeft should be used for columns containing text that is read from
+	// right to left, such as Arabic or Hebrew. Horizontal alignments are mirrored,
+	// ensuring [lipgloss.Left] aligns text to the start of the column
+	RightToLeft
+)
+
+const (
+	leftToRightIsolate = "\u2066"
+	rightToLeftIsolate = "\u2067"
+	popDirIsolate      = "\u2069"
+)
+
+// isolate wraps each line of the text within a unicode bidi isolate, preventing
+// a terminal that supports bidirectional text from reordering any surrounding
+// characters, such as table borders
+func isolate(str string, dir Direction) string {
+	start := leftToRightIsolate
+	if dir == RightToLeft {
+		start = rightToLeftIsolate
+	}
+
+	lines := strings.Split(str, "\n")
+	for i, l := range lines {
+		lines[i] = start + l + popDirIsolate
+	}
+	return strings.Join(lines, "\n")
+}
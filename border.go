@@ -94,4 +94,44 @@ var (
 		TopRight:    "╗",
 		Vertical:    "║",
 	}
+
+	// ASCIIBorder defines a series of ASCII characters for rendering a table with a border
+	// within terminals that do not support unicode
+	ASCIIBorder = TableBorder{
+		Bottom:      "-",
+		BottomJoin:  "+",
+		BottomLeft:  "+",
+		BottomRight: "+",
+		Middle:      "-",
+		MiddleJoin:  "+",
+		MiddleLeft:  "+",
+		MiddleRight: "+",
+		MiddleTop:   "+",
+		Top:         "-",
+		TopJoin:     "+",
+		TopLeft:     "+",
+		TopRight:    "+",
+		Vertical:    "|",
+	}
 )
+
+// ASCII returns a copy of the border with all unicode box-drawing characters
+// replaced by their closest ASCII equivalent
+func (b TableBorder) ASCII() TableBorder {
+	return TableBorder{
+		Bottom:      ascii(b.Bottom, ASCIIBorder.Bottom),
+		BottomJoin:  ascii(b.BottomJoin, ASCIIBorder.BottomJoin),
+		BottomLeft:  ascii(b.BottomLeft, ASCIIBorder.BottomLeft),
+		BottomRight: ascii(b.BottomRight, ASCIIBorder.BottomRight),
+		Middle:      ascii(b.Middle, ASCIIBorder.Middle),
+		MiddleJoin:  ascii(b.MiddleJoin, ASCIIBorder.MiddleJoin),
+		MiddleLeft:  ascii(b.MiddleLeft, ASCIIBorder.MiddleLeft),
+		MiddleRight: ascii(b.MiddleRight, ASCIIBorder.MiddleRight),
+		MiddleTop:   ascii(b.MiddleTop, ASCIIBorder.MiddleTop),
+		Top:         ascii(b.Top, ASCIIBorder.Top),
+		TopJoin:     ascii(b.TopJoin, ASCIIBorder.TopJoin),
+		TopLeft:     ascii(b.TopLeft, ASCIIBorder.TopLeft),
+		TopRight:    ascii(b.TopRight, ASCIIBorder.TopRight),
+		Vertical:    ascii(b.Vertical, ASCIIBorder.Vertical),
+	}
+}
@@ -0,0 +1,190 @@
+package theme
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TableBuilder is an immutable template for configuring a [Table]. Each option
+// returns a new builder, leaving the original untouched, so a single builder can
+// safely be shared across goroutines. The same configuration can then be applied
+// to different data through [TableBuilder.WithData]
+//
+//	tmpl := theme.NewTableBuilder().
+//		Border(theme.ThinBorder).
+//		Widths(8, 20)
+//
+//	tmpl.WithData(data).String()
+type TableBuilder struct {
+	opts []func(*Table)
+}
+
+// NewTableBuilder creates an empty builder, that will create a table with the
+// same defaults as [NewTable]
+func NewTableBuilder() TableBuilder {
+	return TableBuilder{}
+}
+
+func (b TableBuilder) with(opt func(*Table)) TableBuilder {
+	opts := make([]func(*Table), 0, len(b.opts)+1)
+	opts = append(opts, b.opts...)
+	return TableBuilder{opts: append(opts, opt)}
+}
+
+// WithData creates a new table from the given data, applying every option
+// of the builder in turn
+func (b TableBuilder) WithData(data [][]string) *Table {
+	t := NewTable(data)
+	for _, opt := range b.opts {
+		opt(t)
+	}
+	return t
+}
+
+// Border returns a copy of the builder that sets the table border. See [Table.Border]
+func (b TableBuilder) Border(border TableBorder) TableBuilder {
+	return b.with(func(t *Table) { t.Border(border) })
+}
+
+// Theme returns a copy of the builder that sets the theme used to style the table.
+// See [Table.Theme]
+func (b TableBuilder) Theme(th *Theme) TableBuilder {
+	return b.with(func(t *Table) { t.Theme(th) })
+}
+
+// Widths returns a copy of the builder that sets the maximum widths of each column.
+// See [Table.Widths]
+func (b TableBuilder) Widths(w ...int) TableBuilder {
+	w = slices.Clone(w)
+	return b.with(func(t *Table) { t.Widths(w...) })
+}
+
+// ColumnWidths returns a copy of the builder that sets how the width of each column
+// is resolved. See [Table.ColumnWidths]
+func (b TableBuilder) ColumnWidths(w ...ColumnWidth) TableBuilder {
+	w = slices.Clone(w)
+	return b.with(func(t *Table) { t.ColumnWidths(w...) })
+}
+
+// TotalWidth returns a copy of the builder that sets the target width of the entire
+// table. See [Table.TotalWidth]
+func (b TableBuilder) TotalWidth(w int) TableBuilder {
+	return b.with(func(t *Table) { t.TotalWidth(w) })
+}
+
+// HorizontalAlignments returns a copy of the builder that sets the horizontal alignment
+// of columns. See [Table.HorizontalAlignments]
+func (b TableBuilder) HorizontalAlignments(p ...lipgloss.Position) TableBuilder {
+	p = slices.Clone(p)
+	return b.with(func(t *Table) { t.HorizontalAlignments(p...) })
+}
+
+// VerticalAlignments returns a copy of the builder that sets the vertical alignment
+// of columns. See [Table.VerticalAlignments]
+func (b TableBuilder) VerticalAlignments(p ...lipgloss.Position) TableBuilder {
+	p = slices.Clone(p)
+	return b.with(func(t *Table) { t.VerticalAlignments(p...) })
+}
+
+// Directions returns a copy of the builder that sets the reading direction of columns.
+// See [Table.Directions]
+func (b TableBuilder) Directions(d ...Direction) TableBuilder {
+	d = slices.Clone(d)
+	return b.with(func(t *Table) { t.Directions(d...) })
+}
+
+// BidiIsolation returns a copy of the builder that controls whether cells are wrapped
+// within a unicode bidi isolate. See [Table.BidiIsolation]
+func (b TableBuilder) BidiIsolation(on bool) TableBuilder {
+	return b.with(func(t *Table) { t.BidiIsolation(on) })
+}
+
+// Dividers returns a copy of the builder that controls whether a row divider should be
+// rendered. See [Table.Dividers]
+func (b TableBuilder) Dividers(on bool) TableBuilder {
+	return b.with(func(t *Table) { t.Dividers(on) })
+}
+
+// Collapsed returns a copy of the builder that controls whether all internal padding
+// should be removed. See [Table.Collapsed]
+func (b TableBuilder) Collapsed(on bool) TableBuilder {
+	return b.with(func(t *Table) { t.Collapsed(on) })
+}
+
+// Padding returns a copy of the builder that sets the internal padding of every cell.
+// See [Table.Padding]
+func (b TableBuilder) Padding(p ...int) TableBuilder {
+	p = slices.Clone(p)
+	return b.with(func(t *Table) { t.Padding(p...) })
+}
+
+// ColumnPadding returns a copy of the builder that sets the internal padding of every
+// cell within a column. See [Table.ColumnPadding]
+func (b TableBuilder) ColumnPadding(col int, p ...int) TableBuilder {
+	p = slices.Clone(p)
+	return b.with(func(t *Table) { t.ColumnPadding(col, p...) })
+}
+
+// RowSpacing returns a copy of the builder that sets the number of blank lines rendered
+// between each row. See [Table.RowSpacing]
+func (b TableBuilder) RowSpacing(n int) TableBuilder {
+	return b.with(func(t *Table) { t.RowSpacing(n) })
+}
+
+// Priorities returns a copy of the builder that sets the priority of columns. See
+// [Table.Priorities]
+func (b TableBuilder) Priorities(p ...int) TableBuilder {
+	p = slices.Clone(p)
+	return b.with(func(t *Table) { t.Priorities(p...) })
+}
+
+// HiddenIndicator returns a copy of the builder that controls whether hidden columns
+// should be indicated. See [Table.HiddenIndicator]
+func (b TableBuilder) HiddenIndicator(on bool) TableBuilder {
+	return b.with(func(t *Table) { t.HiddenIndicator(on) })
+}
+
+// Order returns a copy of the builder that reorders columns by their key. See
+// [Table.Order]
+func (b TableBuilder) Order(keys ...string) TableBuilder {
+	keys = slices.Clone(keys)
+	return b.with(func(t *Table) { t.Order(keys...) })
+}
+
+// RowNumbers returns a copy of the builder that prepends an auto-generated index column.
+// See [Table.RowNumbers]
+func (b TableBuilder) RowNumbers(start int, header string) TableBuilder {
+	return b.with(func(t *Table) { t.RowNumbers(start, header) })
+}
+
+// Records returns a copy of the builder that controls whether the table should be
+// rendered as a series of records. See [Table.Records]
+func (b TableBuilder) Records(on bool) TableBuilder {
+	return b.with(func(t *Table) { t.Records(on) })
+}
+
+// Parent returns a copy of the builder that nests a row beneath a parent row. See
+// [Table.Parent]
+func (b TableBuilder) Parent(row, parent int) TableBuilder {
+	return b.with(func(t *Table) { t.Parent(row, parent) })
+}
+
+// Fold returns a copy of the builder that controls whether all children of a row should
+// be hidden. See [Table.Fold]
+func (b TableBuilder) Fold(row int, on bool) TableBuilder {
+	return b.with(func(t *Table) { t.Fold(row, on) })
+}
+
+// FoldMarkers returns a copy of the builder that controls whether fold markers should be
+// rendered. See [Table.FoldMarkers]
+func (b TableBuilder) FoldMarkers(on bool) TableBuilder {
+	return b.with(func(t *Table) { t.FoldMarkers(on) })
+}
+
+// Nest returns a copy of the builder that embeds a block of content within a cell. See
+// [Table.Nest]
+func (b TableBuilder) Nest(row, col int, block fmt.Stringer) TableBuilder {
+	return b.with(func(t *Table) { t.Nest(row, col, block) })
+}
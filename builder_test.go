@@ -0,0 +1,66 @@
+package theme_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	theme "github.com/purpleclay/lipgloss-theme"
+)
+
+func TestTableBuilderImmutable(t *testing.T) {
+	t.Parallel()
+	base := theme.NewTableBuilder().Widths(10)
+	_ = base.Border(theme.ThinBorder).HorizontalAlignments(lipgloss.Right)
+
+	expected := theme.NewTable(data).Widths(10).String()
+	if got := base.WithData(data).String(); got != expected {
+		t.Errorf("expected builder to be left untouched, got:\n%s", got)
+	}
+}
+
+func TestTableBuilderWithData(t *testing.T) {
+	t.Parallel()
+	tmpl := theme.NewTableBuilder().
+		Border(theme.ThinBorder).
+		Widths(14, 8, 30, 10).
+		RowNumbers(1, "#")
+
+	expected := theme.NewTable(data).
+		Border(theme.ThinBorder).
+		Widths(14, 8, 30, 10).
+		RowNumbers(1, "#").
+		String()
+
+	if got := tmpl.WithData(data).String(); got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestTableBuilderConcurrent(t *testing.T) {
+	t.Parallel()
+	tmpl := theme.NewTableBuilder().
+		Border(theme.RoundedThinBorder).
+		TotalWidth(80).
+		ColumnWidths(theme.AutoWidth(), theme.AutoWidth(), theme.FlexWidth(1))
+
+	datasets := [][][]string{data, unicodeData, rtlData}
+	expected := make([]string, len(datasets))
+	for i, d := range datasets {
+		expected[i] = tmpl.WithData(d).String()
+	}
+
+	var wg sync.WaitGroup
+	for n := 0; n < 10; n++ {
+		for i, d := range datasets {
+			wg.Add(1)
+			go func(i int, d [][]string) {
+				defer wg.Done()
+				if got := tmpl.Dividers(false).Dividers(true).WithData(d).String(); got != expected[i] {
+					t.Errorf("expected:\n%s\ngot:\n%s", expected[i], got)
+				}
+			}(i, d)
+		}
+	}
+	wg.Wait()
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"strings"
@@ -12,35 +13,82 @@ import (
 const loremIpsum = "Lorem ipsum dolor sit amet"
 
 func main() {
+	safe := flag.Bool("safe", false, "use the color blind safe variant of the theme")
+	highContrast := flag.Bool("high-contrast", theme.HighContrastEnabled(), "use the high contrast variant of the theme")
+	simulate := flag.String("simulate", "", "simulate a color vision deficiency (protanopia, deuteranopia or tritanopia)")
+	flag.Parse()
+
+	th, err := selectTheme(*safe, *highContrast, *simulate)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
 	out := lipgloss.JoinVertical(
 		lipgloss.Top,
-		palette(),
+		palette(th),
+		"",
+		accents(th),
 		"",
-		typogrpahy(),
+		tokens(th),
 		"",
-		glyphs(),
+		typogrpahy(th),
 		"",
-		tables(),
+		glyphs(th),
+		"",
+		tables(th),
 	)
 	fmt.Fprint(os.Stdout, lipgloss.NewStyle().Margin(2, 2).Render(out))
 }
 
-func palette() string {
+func selectTheme(safe, highContrast bool, simulate string) (*theme.Theme, error) {
+	newTheme := theme.NewTheme
+	if highContrast {
+		newTheme = theme.NewHighContrastTheme
+	}
+
+	th := newTheme(theme.PurpleClay)
+	if safe {
+		th = th.ColorBlindSafe()
+	}
+
+	if simulate == "" {
+		return th, nil
+	}
+
+	for _, d := range []theme.Deficiency{theme.Protanopia, theme.Deuteranopia, theme.Tritanopia} {
+		if d.String() == simulate {
+			sim, err := th.Palette.Simulate(d)
+			if err != nil {
+				return nil, err
+			}
+
+			tk, err := th.Tokens.Simulate(d)
+			if err != nil {
+				return nil, err
+			}
+			return newTheme(sim).WithTokens(tk), nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported color vision deficiency %q", simulate)
+}
+
+func palette(th *theme.Theme) string {
 	colorCell := lipgloss.NewStyle().Height(3).Width(12)
 	labelCell := lipgloss.NewStyle().Width(12).AlignHorizontal(lipgloss.Center)
 
 	colors := []string{
-		colorCell.Background(theme.S50).Render(),
-		colorCell.Background(theme.S100).Render(),
-		colorCell.Background(theme.S200).Render(),
-		colorCell.Background(theme.S300).Render(),
-		colorCell.Background(theme.S400).Render(),
-		colorCell.Background(theme.S500).Render(),
-		colorCell.Background(theme.S600).Render(),
-		colorCell.Background(theme.S700).Render(),
-		colorCell.Background(theme.S800).Render(),
-		colorCell.Background(theme.S900).Render(),
-		colorCell.Background(theme.S950).Render(),
+		colorCell.Background(th.Palette.S50).Render(),
+		colorCell.Background(th.Palette.S100).Render(),
+		colorCell.Background(th.Palette.S200).Render(),
+		colorCell.Background(th.Palette.S300).Render(),
+		colorCell.Background(th.Palette.S400).Render(),
+		colorCell.Background(th.Palette.S500).Render(),
+		colorCell.Background(th.Palette.S600).Render(),
+		colorCell.Background(th.Palette.S700).Render(),
+		colorCell.Background(th.Palette.S800).Render(),
+		colorCell.Background(th.Palette.S900).Render(),
+		colorCell.Background(th.Palette.S950).Render(),
 	}
 	labels := []string{
 		labelCell.Render("50"),
@@ -58,91 +106,165 @@ func palette() string {
 
 	return lipgloss.JoinVertical(
 		lipgloss.Top,
-		theme.H1.Render("Monochromatic Palette"),
+		th.H1.Render("Monochromatic Palette"),
 		theme.NewTable([][]string{colors, labels}).
+			Theme(th).
 			Dividers(false).
 			Collapsed(true).
 			String(),
 	)
 }
 
-func typogrpahy() string {
+func accents(th *theme.Theme) string {
+	colorCell := lipgloss.NewStyle().Height(1).Width(12)
+	scales := [][]lipgloss.Color{
+		{
+			th.Palette.Green50, th.Palette.Green100, th.Palette.Green200, th.Palette.Green300, th.Palette.Green400, th.Palette.Green500,
+			th.Palette.Green600, th.Palette.Green700, th.Palette.Green800, th.Palette.Green900, th.Palette.Green950,
+		},
+		{
+			th.Palette.Amber50, th.Palette.Amber100, th.Palette.Amber200, th.Palette.Amber300, th.Palette.Amber400, th.Palette.Amber500,
+			th.Palette.Amber600, th.Palette.Amber700, th.Palette.Amber800, th.Palette.Amber900, th.Palette.Amber950,
+		},
+		{
+			th.Palette.Red50, th.Palette.Red100, th.Palette.Red200, th.Palette.Red300, th.Palette.Red400, th.Palette.Red500,
+			th.Palette.Red600, th.Palette.Red700, th.Palette.Red800, th.Palette.Red900, th.Palette.Red950,
+		},
+		{
+			th.Palette.Blue50, th.Palette.Blue100, th.Palette.Blue200, th.Palette.Blue300, th.Palette.Blue400, th.Palette.Blue500,
+			th.Palette.Blue600, th.Palette.Blue700, th.Palette.Blue800, th.Palette.Blue900, th.Palette.Blue950,
+		},
+	}
+
+	rows := make([][]string, 0, len(scales))
+	for _, scale := range scales {
+		row := make([]string, 0, len(scale))
+		for _, c := range scale {
+			row = append(row, colorCell.Background(c).Render())
+		}
+		rows = append(rows, row)
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Top,
+		th.H1.Render("Accent Palette"),
+		theme.NewTable(rows).
+			Theme(th).
+			Dividers(false).
+			Collapsed(true).
+			String(),
+	)
+}
+
+func tokens(th *theme.Theme) string {
+	colorCell := lipgloss.NewStyle().Height(1).Width(10)
+	labelCell := lipgloss.NewStyle().Width(10).AlignHorizontal(lipgloss.Center)
+
+	names := []string{"primary", "secondary", "surface", "muted", "success", "warning", "danger", "info", "border", "link"}
+	colors := []lipgloss.AdaptiveColor{
+		th.Tokens.Primary,
+		th.Tokens.Secondary,
+		th.Tokens.Surface,
+		th.Tokens.Muted,
+		th.Tokens.Success,
+		th.Tokens.Warning,
+		th.Tokens.Danger,
+		th.Tokens.Info,
+		th.Tokens.Border,
+		th.Tokens.Link,
+	}
+
+	swatches := make([]string, 0, len(colors))
+	labels := make([]string, 0, len(names))
+	for i, c := range colors {
+		swatches = append(swatches, colorCell.Background(c).Render())
+		labels = append(labels, labelCell.Render(names[i]))
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Top,
+		th.H1.Render("Semantic Tokens"),
+		theme.NewTable([][]string{swatches, labels}).
+			Theme(th).
+			Dividers(false).
+			Collapsed(true).
+			String(),
+	)
+}
+
+func typogrpahy(th *theme.Theme) string {
 	data := [][]string{
-		{"h1", theme.H1.Render(loremIpsum), "", "u", theme.U.Render(loremIpsum)},
-		{"h2", theme.H2.Render(loremIpsum), "", "i", theme.I.Render(loremIpsum)},
-		{"h3", theme.H3.Render(loremIpsum), "", "b", theme.B.Render(loremIpsum)},
-		{"h4", theme.H4.Render(loremIpsum), "", "s", theme.S.Render(loremIpsum)},
-		{"h5", theme.H5.Render(loremIpsum), "", "a", theme.A.Render(loremIpsum)},
-		{"h6", theme.H6.Render(loremIpsum), "", "mark", strings.Replace(loremIpsum, "dolor", theme.Mark.Render("dolor"), 1)},
+		{"h1", th.H1.Render(loremIpsum), "", "u", th.U.Render(loremIpsum)},
+		{"h2", th.H2.Render(loremIpsum), "", "i", th.I.Render(loremIpsum)},
+		{"h3", th.H3.Render(loremIpsum), "", "b", th.B.Render(loremIpsum)},
+		{"h4", th.H4.Render(loremIpsum), "", "s", th.S.Render(loremIpsum)},
+		{"h5", th.H5.Render(loremIpsum), "", "a", th.A.Render(loremIpsum)},
+		{"h6", th.H6.Render(loremIpsum), "", "mark", strings.Replace(loremIpsum, "dolor", th.Mark.Render("dolor"), 1)},
 	}
 
 	return lipgloss.JoinVertical(
 		lipgloss.Top,
-		theme.H2.Render("Typography"),
-		theme.NewTable(data).Collapsed(true).Dividers(false).Widths(6, 28, 6, 6, 28).String(),
+		th.H2.Render("Typography"),
+		theme.NewTable(data).Theme(th).Collapsed(true).Dividers(false).Widths(6, 28, 6, 6, 28).String(),
 	)
 }
 
-func glyphs() string {
+func glyphs(th *theme.Theme) string {
 	data := [][]string{
-		{"tick", theme.Tick + " " + loremIpsum},
-		{"cross", theme.Cross + " " + loremIpsum},
-		{"bang", theme.Bang + " " + loremIpsum},
+		{"tick", th.Tick + " " + loremIpsum},
+		{"cross", th.Cross + " " + loremIpsum},
+		{"bang", th.Bang + " " + loremIpsum},
 	}
 
 	return lipgloss.JoinVertical(
 		lipgloss.Top,
-		theme.H3.Render("Glyphs"),
-		theme.NewTable(data).Collapsed(true).Dividers(false).Widths(6, 28).String(),
+		th.H3.Render("Glyphs"),
+		theme.NewTable(data).Theme(th).Collapsed(true).Dividers(false).Widths(6, 28).String(),
 	)
 }
 
-func tables() string {
+func tables(th *theme.Theme) string {
 	tbl := [][]string{{"1", "2", "3"}, {"4", "5", "6"}}
 
 	thinBorder := theme.NewTable(tbl).
+		Theme(th).
 		Border(theme.ThinBorder).
 		Widths(10).
-		HorizontalAlignments(lipgloss.Left, lipgloss.Center, lipgloss.Right).
-		String()
+		HorizontalAlignments(lipgloss.Left, lipgloss.Center, lipgloss.Right)
 
 	thickBorder := theme.NewTable(tbl).
+		Theme(th).
 		Border(theme.ThickBorder).
 		Widths(10).
-		HorizontalAlignments(lipgloss.Left, lipgloss.Center, lipgloss.Right).
-		String()
+		HorizontalAlignments(lipgloss.Left, lipgloss.Center, lipgloss.Right)
 
 	roundedBorder := theme.NewTable(tbl).
+		Theme(th).
 		Border(theme.RoundedThinBorder).
 		Widths(10).
-		HorizontalAlignments(lipgloss.Left, lipgloss.Center, lipgloss.Right).
-		String()
+		HorizontalAlignments(lipgloss.Left, lipgloss.Center, lipgloss.Right)
 
 	doubleBorder := theme.NewTable(tbl).
+		Theme(th).
 		Border(theme.DoubleBorder).
 		Widths(10).
-		HorizontalAlignments(lipgloss.Left, lipgloss.Center, lipgloss.Right).
-		String()
+		HorizontalAlignments(lipgloss.Left, lipgloss.Center, lipgloss.Right)
 
-	labelCell := lipgloss.NewStyle().AlignVertical(lipgloss.Center)
 	data := [][]string{
-		{
-			labelCell.Height(lipgloss.Height(thinBorder)).Render("thin"),
-			thinBorder,
-			labelCell.Height(lipgloss.Height(thickBorder)).Render("thick"),
-			thickBorder,
-		},
-		{
-			labelCell.Height(lipgloss.Height(roundedBorder)).Render("rounded"),
-			roundedBorder,
-			labelCell.Height(lipgloss.Height(doubleBorder)).Render("double"),
-			doubleBorder,
-		},
+		{"thin", "", "thick", ""},
+		{"rounded", "", "double", ""},
 	}
 
 	return lipgloss.JoinVertical(
 		lipgloss.Top,
-		theme.H4.Render("Tables"),
-		theme.NewTable(data).String(),
+		th.H4.Render("Tables"),
+		theme.NewTable(data).
+			Theme(th).
+			VerticalAlignments(lipgloss.Center).
+			Nest(0, 1, thinBorder).
+			Nest(0, 3, thickBorder).
+			Nest(1, 1, roundedBorder).
+			Nest(1, 3, doubleBorder).
+			String(),
 	)
 }
@@ -0,0 +1,117 @@
+package theme
+
+import (
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// ColorDisabled inspects the environment to determine whether color has been
+// disabled, by setting NO_COLOR, CLICOLOR=0 or FORCE_COLOR=0. NO_COLOR always
+// takes precedence, while CLICOLOR=0 can be overridden by either FORCE_COLOR or
+// CLICOLOR_FORCE
+func ColorDisabled() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return true
+	}
+
+	if level, ok := forceColor(); ok {
+		return level == termenv.Ascii
+	}
+
+	if cliColorForced() {
+		return false
+	}
+	return os.Getenv("CLICOLOR") == "0"
+}
+
+// ForcedColorProfile inspects the environment to determine whether color has been
+// forced, by setting FORCE_COLOR or CLICOLOR_FORCE, even if the output is not a
+// terminal. FORCE_COLOR can select a level of support: 1 (ANSI), 2 (ANSI256) or
+// 3 (TrueColor). Color is never forced if it has been disabled
+func ForcedColorProfile() (termenv.Profile, bool) {
+	if ColorDisabled() {
+		return termenv.Ascii, false
+	}
+
+	if level, ok := forceColor(); ok {
+		return level, true
+	}
+
+	if cliColorForced() {
+		return termenv.ANSI, true
+	}
+	return termenv.Ascii, false
+}
+
+func forceColor() (termenv.Profile, bool) {
+	switch level := strings.ToLower(os.Getenv("FORCE_COLOR")); level {
+	case "":
+		return termenv.Ascii, false
+	case "0", "false":
+		return termenv.Ascii, true
+	case "2":
+		return termenv.ANSI256, true
+	case "3":
+		return termenv.TrueColor, true
+	default:
+		return termenv.ANSI, true
+	}
+}
+
+func cliColorForced() bool {
+	forced := os.Getenv("CLICOLOR_FORCE")
+	return forced != "" && forced != "0"
+}
+
+// colorRenderer resolves the renderer that styles of a theme should be bound to,
+// honouring any color settings within the environment. The given renderer is
+// never modified, as it may be shared, such as the default renderer. If color is
+// forced, styles are bound to a copy of the renderer upgraded to the forced color
+// profile. If color is disabled, styles are bound to a copy of the renderer that
+// can still render emphasis, such as bold and underline, providing the output is
+// a terminal
+func colorRenderer(r *lipgloss.Renderer) *lipgloss.Renderer {
+	var profile termenv.Profile
+	if ColorDisabled() {
+		if r.Output().ColorProfile() == termenv.Ascii {
+			return r
+		}
+		profile = termenv.ANSI
+	} else if p, ok := ForcedColorProfile(); ok && r.ColorProfile() > p {
+		// Profiles are ordered from the most (TrueColor) to the least (Ascii) capable
+		profile = p
+	} else {
+		return r
+	}
+
+	nr := lipgloss.NewRenderer(r.Output())
+	nr.SetColorProfile(profile)
+	nr.SetHasDarkBackground(r.HasDarkBackground())
+	return nr
+}
+
+// withoutColor replaces any color within the theme with a non color based form
+// of emphasis, ensuring headers and highlighted text remain distinguishable from
+// body text
+func (th *Theme) withoutColor() {
+	h := th.renderer.NewStyle().Padding(0, 1)
+
+	th.noColor = true
+	th.A = th.A.UnsetForeground()
+	th.H1 = h.Bold(true).Reverse(true).Underline(true)
+	th.H2 = h.Bold(true).Reverse(true)
+	th.H3 = h.Bold(true).Underline(true)
+	th.H4 = h.Bold(true)
+	th.H5 = h.Reverse(true).Underline(true)
+	th.H6 = h.Underline(true)
+	th.Mark = th.Mark.UnsetBackground().UnsetForeground().Reverse(true)
+	th.Logging.Key = th.Logging.Key.UnsetForeground().Bold(true)
+	for k, s := range th.Logging.Keys {
+		th.Logging.Keys[k] = s.UnsetForeground().Bold(true)
+	}
+	th.BorderStyle = th.BorderStyle.UnsetForeground()
+	th.resetGlyphs()
+}
@@ -0,0 +1,198 @@
+package theme_test
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	theme "github.com/purpleclay/lipgloss-theme"
+)
+
+func setColorEnv(t *testing.T, env map[string]string) {
+	t.Helper()
+	for _, key := range []string{"NO_COLOR", "CLICOLOR", "CLICOLOR_FORCE", "FORCE_COLOR"} {
+		t.Setenv(key, env[key])
+	}
+}
+
+func TestColorDisabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected bool
+	}{
+		{name: "NoEnv", env: map[string]string{}, expected: false},
+		{name: "NoColor", env: map[string]string{"NO_COLOR": "1"}, expected: true},
+		{name: "NoColorIgnoresForce", env: map[string]string{"NO_COLOR": "1", "FORCE_COLOR": "3", "CLICOLOR_FORCE": "1"}, expected: true},
+		{name: "CLIColorOff", env: map[string]string{"CLICOLOR": "0"}, expected: true},
+		{name: "CLIColorOffForced", env: map[string]string{"CLICOLOR": "0", "CLICOLOR_FORCE": "1"}, expected: false},
+		{name: "CLIColorOffForceColor", env: map[string]string{"CLICOLOR": "0", "FORCE_COLOR": "1"}, expected: false},
+		{name: "ForceColorOff", env: map[string]string{"FORCE_COLOR": "0"}, expected: true},
+		{name: "ForceColorFalse", env: map[string]string{"FORCE_COLOR": "false", "CLICOLOR_FORCE": "1"}, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setColorEnv(t, tt.env)
+
+			if got := theme.ColorDisabled(); got != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestForcedColorProfile(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected termenv.Profile
+		forced   bool
+	}{
+		{name: "NoEnv", env: map[string]string{}, expected: termenv.Ascii, forced: false},
+		{name: "ForceColor", env: map[string]string{"FORCE_COLOR": "1"}, expected: termenv.ANSI, forced: true},
+		{name: "ForceColorTrue", env: map[string]string{"FORCE_COLOR": "true"}, expected: termenv.ANSI, forced: true},
+		{name: "ForceColorANSI256", env: map[string]string{"FORCE_COLOR": "2"}, expected: termenv.ANSI256, forced: true},
+		{name: "ForceColorTrueColor", env: map[string]string{"FORCE_COLOR": "3"}, expected: termenv.TrueColor, forced: true},
+		{name: "CLIColorForce", env: map[string]string{"CLICOLOR_FORCE": "1"}, expected: termenv.ANSI, forced: true},
+		{name: "CLIColorForceOff", env: map[string]string{"CLICOLOR_FORCE": "0"}, expected: termenv.Ascii, forced: false},
+		{name: "NoColor", env: map[string]string{"NO_COLOR": "1", "FORCE_COLOR": "3"}, expected: termenv.Ascii, forced: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setColorEnv(t, tt.env)
+
+			p, forced := theme.ForcedColorProfile()
+			if p != tt.expected || forced != tt.forced {
+				t.Errorf("expected (%v, %t), got (%v, %t)", tt.expected, tt.forced, p, forced)
+			}
+		})
+	}
+}
+
+func TestThemeWithoutColor(t *testing.T) {
+	tests := []struct {
+		name     string
+		newTheme func(*lipgloss.Renderer, theme.Palette) *theme.Theme
+	}{
+		{name: "Standard", newTheme: theme.NewThemeWithRenderer},
+		{name: "HighContrast", newTheme: theme.NewHighContrastThemeWithRenderer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setColorEnv(t, map[string]string{"NO_COLOR": "1"})
+			t.Setenv("TERM", "xterm-256color")
+
+			r := lipgloss.NewRenderer(io.Discard, termenv.WithTTY(true))
+			th := tt.newTheme(r, theme.PurpleClay)
+
+			rendered := map[string]string{}
+			styles := []lipgloss.Style{th.H1, th.H2, th.H3, th.H4, th.H5, th.H6, th.Mark}
+			for i, s := range styles {
+				out := s.Render("Gotham")
+				if hasColor(out) {
+					t.Errorf("expected no color, got %q", out)
+				}
+
+				if !strings.Contains(out, "\x1b[") {
+					t.Errorf("expected emphasis, got %q", out)
+				}
+
+				if prev, ok := rendered[out]; ok {
+					t.Errorf("expected %q to be distinguishable from %s", out, prev)
+				}
+				rendered[out] = [...]string{"H1", "H2", "H3", "H4", "H5", "H6", "Mark"}[i]
+			}
+
+			for _, g := range []string{th.Tick, th.Cross, th.Bang} {
+				if strings.Contains(g, "\x1b[") {
+					t.Errorf("expected glyph without color, got %q", g)
+				}
+			}
+		})
+	}
+}
+
+// hasColor reports whether any SGR sequence within the output sets a foreground
+// or background color, including the 16 colors of the ANSI profile
+func hasColor(out string) bool {
+	for _, seq := range strings.Split(out, "\x1b[")[1:] {
+		params, _, ok := strings.Cut(seq, "m")
+		if !ok {
+			continue
+		}
+
+		for _, p := range strings.Split(params, ";") {
+			n, err := strconv.Atoi(p)
+			if err != nil {
+				continue
+			}
+
+			if (n >= 30 && n <= 49) || (n >= 90 && n <= 107) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestThemeWithoutColorNotTerminal(t *testing.T) {
+	setColorEnv(t, map[string]string{"NO_COLOR": "1"})
+
+	th := theme.NewThemeWithRenderer(lipgloss.NewRenderer(io.Discard), theme.PurpleClay)
+	if out := th.H1.Render("Gotham"); out != " Gotham " {
+		t.Errorf("expected plain text when not a terminal, got %q", out)
+	}
+}
+
+func TestThemeForcedColor(t *testing.T) {
+	setColorEnv(t, map[string]string{"FORCE_COLOR": "3"})
+
+	r := lipgloss.NewRenderer(io.Discard)
+	th := theme.NewThemeWithRenderer(r, theme.PurpleClay)
+	if out := th.H1.Render("Gotham"); !strings.Contains(out, "48;2;") {
+		t.Errorf("expected true color when forced, got %q", out)
+	}
+}
+
+func TestThemeColorEnvLeavesRendererUntouched(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+	}{
+		{name: "Forced", env: map[string]string{"FORCE_COLOR": "3"}},
+		{name: "Disabled", env: map[string]string{"NO_COLOR": "1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setColorEnv(t, tt.env)
+			t.Setenv("TERM", "xterm-256color")
+
+			r := lipgloss.NewRenderer(io.Discard, termenv.WithTTY(true))
+			r.SetColorProfile(termenv.Ascii)
+
+			th := theme.NewThemeWithRenderer(r, theme.PurpleClay)
+			if th.Renderer() != r {
+				t.Errorf("expected the theme to return the renderer it was created with")
+			}
+
+			if p := r.ColorProfile(); p != termenv.Ascii {
+				t.Errorf("expected the color profile of the renderer to be left untouched, got %v", p)
+			}
+		})
+	}
+}
+
+func TestDefaultForcedColorLeavesDefaultRendererUntouched(t *testing.T) {
+	setColorEnv(t, map[string]string{"FORCE_COLOR": "3"})
+
+	theme.Default()
+	if p := lipgloss.ColorProfile(); p != termenv.Ascii {
+		t.Errorf("expected the profile of the default renderer to be left untouched, got %v", p)
+	}
+}
@@ -0,0 +1,239 @@
+package theme
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// The typical background and default text colors of a light and dark terminal,
+// used when a style does not set its own
+const (
+	lightBackground = lipgloss.Color("#ffffff")
+	lightText       = lipgloss.Color("#000000")
+	darkBackground  = lipgloss.Color("#000000")
+	darkText        = lipgloss.Color("#ffffff")
+)
+
+// The minimum WCAG 2.x contrast ratios needed to meet level AA
+const (
+	aaText    = 4.5
+	aaNonText = 3.0
+)
+
+// ContrastRatio calculates the WCAG 2.x contrast ratio between a foreground and
+// background hex color. Ranges from 1 (no contrast) to 21 (black on white)
+func ContrastRatio(fg, bg lipgloss.Color) (float64, error) {
+	fgY, err := relativeLuminance(fg)
+	if err != nil {
+		return 0, err
+	}
+
+	bgY, err := relativeLuminance(bg)
+	if err != nil {
+		return 0, err
+	}
+
+	lighter, darker := max(fgY, bgY), min(fgY, bgY)
+	return (lighter + 0.05) / (darker + 0.05), nil
+}
+
+func relativeLuminance(c lipgloss.Color) (float64, error) {
+	col, err := colorful.Hex(string(c))
+	if err != nil {
+		return 0, fmt.Errorf("contrast: expected a hex color, got %q", string(c))
+	}
+
+	linear := func(v float64) float64 {
+		if v <= 0.04045 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	return 0.2126*linear(col.R) + 0.7152*linear(col.G) + 0.0722*linear(col.B), nil
+}
+
+// APCAContrast calculates the APCA lightness contrast (Lc) of a foreground hex color
+// against a background hex color. Dark text on a light background produces a positive
+// value of up to 106, while light text on a dark background produces a negative value
+// of up to -108. Values closer to 0 have less contrast
+func APCAContrast(fg, bg lipgloss.Color) (float64, error) {
+	fgY, err := screenLuminance(fg)
+	if err != nil {
+		return 0, err
+	}
+
+	bgY, err := screenLuminance(bg)
+	if err != nil {
+		return 0, err
+	}
+
+	if math.Abs(bgY-fgY) < 0.0005 {
+		return 0, nil
+	}
+
+	var lc float64
+	if bgY > fgY {
+		sapc := (math.Pow(bgY, 0.56) - math.Pow(fgY, 0.57)) * 1.14
+		if sapc >= 0.1 {
+			lc = sapc - 0.027
+		}
+	} else {
+		sapc := (math.Pow(bgY, 0.65) - math.Pow(fgY, 0.62)) * 1.14
+		if sapc <= -0.1 {
+			lc = sapc + 0.027
+		}
+	}
+	return lc * 100, nil
+}
+
+// screenLuminance estimates the luminance of a color as used by APCA, with a soft
+// clamp applied to near black colors
+func screenLuminance(c lipgloss.Color) (float64, error) {
+	col, err := colorful.Hex(string(c))
+	if err != nil {
+		return 0, fmt.Errorf("contrast: expected a hex color, got %q", string(c))
+	}
+
+	y := 0.2126729*math.Pow(col.R, 2.4) + 0.7151522*math.Pow(col.G, 2.4) + 0.0721750*math.Pow(col.B, 2.4)
+	if y < 0.022 {
+		y += math.Pow(0.022-y, 1.414)
+	}
+	return y, nil
+}
+
+// Pairing defines a foreground and background color that are rendered together
+// by a theme, along with their contrast
+type Pairing struct {
+	// Name identifies the style and terminal background of the pairing, such
+	// as "H1 (light)"
+	Name string
+
+	Foreground lipgloss.Color
+	Background lipgloss.Color
+
+	// NonText is set if the foreground is a graphical element, such as a border
+	// or glyph, that only needs a contrast ratio of 3:1 to meet level AA
+	NonText bool
+
+	// Ratio is the WCAG 2.x contrast ratio of the pairing
+	Ratio float64
+
+	// APCA is the APCA lightness contrast (Lc) of the pairing
+	APCA float64
+}
+
+// AA reports whether the pairing meets the WCAG 2.x level AA contrast ratio. Text
+// needs a ratio of at least 4.5:1, while non-text needs at least 3:1
+func (p Pairing) AA() bool {
+	if p.NonText {
+		return p.Ratio >= aaNonText
+	}
+	return p.Ratio >= aaText
+}
+
+// Audit calculates the contrast of every foreground and background pairing used by
+// the theme, against both a typical light (white) and dark (black) terminal. Any
+// style without its own foreground or background is paired with the default
+// colors of the terminal. Header backgrounds are not paired with the terminal, as
+// a header is read through its text rather than its background. Only the high
+// contrast theme sets its headers apart from the terminal, see [NewHighContrastTheme]
+//
+//	pairings, _ := theme.Default().Audit()
+//	for _, p := range pairings {
+//		if !p.AA() {
+//			fmt.Printf("%s: %.2f:1\n", p.Name, p.Ratio)
+//		}
+//	}
+func (th *Theme) Audit() ([]Pairing, error) {
+	type auditStyle struct {
+		name    string
+		style   lipgloss.Style
+		nonText bool
+	}
+
+	styles := []auditStyle{
+		{name: "A", style: th.A},
+		{name: "H1", style: th.H1},
+		{name: "H2", style: th.H2},
+		{name: "H3", style: th.H3},
+		{name: "H4", style: th.H4},
+		{name: "H5", style: th.H5},
+		{name: "H6", style: th.H6},
+		{name: "Mark", style: th.Mark},
+		{name: "Tick", style: lipgloss.NewStyle().Foreground(th.Tokens.Success), nonText: true},
+		{name: "Cross", style: lipgloss.NewStyle().Foreground(th.Tokens.Danger), nonText: true},
+		{name: "Bang", style: lipgloss.NewStyle().Foreground(th.Tokens.Warning), nonText: true},
+		{name: "Logging.Key", style: th.Logging.Key},
+		{name: "Table.Border", style: th.BorderStyle, nonText: true},
+	}
+
+	keys := make([]string, 0, len(th.Logging.Keys))
+	for k := range th.Logging.Keys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		styles = append(styles, auditStyle{name: "Logging.Keys[" + k + "]", style: th.Logging.Keys[k]})
+	}
+
+	var pairings []Pairing
+	for _, s := range styles {
+		for _, term := range []struct {
+			name   string
+			dark   bool
+			fg, bg lipgloss.Color
+		}{
+			{name: "light", fg: lightText, bg: lightBackground},
+			{name: "dark", dark: true, fg: darkText, bg: darkBackground},
+		} {
+			p := Pairing{
+				Name:       fmt.Sprintf("%s (%s)", s.name, term.name),
+				Foreground: resolveColor(s.style.GetForeground(), term.dark, term.fg),
+				Background: resolveColor(s.style.GetBackground(), term.dark, term.bg),
+				NonText:    s.nonText,
+			}
+
+			var err error
+			if p.Ratio, err = ContrastRatio(p.Foreground, p.Background); err != nil {
+				return nil, fmt.Errorf("%s: %w", p.Name, err)
+			}
+
+			if p.APCA, err = APCAContrast(p.Foreground, p.Background); err != nil {
+				return nil, fmt.Errorf("%s: %w", p.Name, err)
+			}
+			pairings = append(pairings, p)
+		}
+	}
+	return pairings, nil
+}
+
+// resolveColor resolves a terminal color to the hex color rendered within a light
+// or dark terminal, returning the fallback if no color is set
+func resolveColor(c lipgloss.TerminalColor, dark bool, fallback lipgloss.Color) lipgloss.Color {
+	var hex string
+	switch col := c.(type) {
+	case lipgloss.Color:
+		hex = string(col)
+	case lipgloss.AdaptiveColor:
+		hex = col.Light
+		if dark {
+			hex = col.Dark
+		}
+	case lipgloss.CompleteColor:
+		hex = col.TrueColor
+	case lipgloss.CompleteAdaptiveColor:
+		hex = col.Light.TrueColor
+		if dark {
+			hex = col.Dark.TrueColor
+		}
+	}
+
+	if hex == "" {
+		return fallback
+	}
+	return lipgloss.Color(hex)
+}
@@ -0,0 +1,107 @@
+package theme_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	theme "github.com/purpleclay/lipgloss-theme"
+)
+
+func TestContrastRatio(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		fg       lipgloss.Color
+		bg       lipgloss.Color
+		expected float64
+	}{
+		{name: "BlackOnWhite", fg: "#000000", bg: "#ffffff", expected: 21},
+		{name: "WhiteOnBlack", fg: "#ffffff", bg: "#000000", expected: 21},
+		{name: "SameColor", fg: "#48009f", bg: "#48009f", expected: 1},
+		{name: "GreyOnWhite", fg: "#767676", bg: "#ffffff", expected: 4.54},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ratio, err := theme.ContrastRatio(tt.fg, tt.bg)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if math.Abs(ratio-tt.expected) > 0.01 {
+				t.Errorf("expected a contrast ratio of %.2f, got %.2f", tt.expected, ratio)
+			}
+		})
+	}
+}
+
+func TestAPCAContrast(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		fg       lipgloss.Color
+		bg       lipgloss.Color
+		expected float64
+	}{
+		{name: "BlackOnWhite", fg: "#000000", bg: "#ffffff", expected: 106.04},
+		{name: "WhiteOnBlack", fg: "#ffffff", bg: "#000000", expected: -107.88},
+		{name: "GreyOnWhite", fg: "#888888", bg: "#ffffff", expected: 63.06},
+		{name: "SameColor", fg: "#48009f", bg: "#48009f", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lc, err := theme.APCAContrast(tt.fg, tt.bg)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if math.Abs(lc-tt.expected) > 0.01 {
+				t.Errorf("expected a lightness contrast of %.2f, got %.2f", tt.expected, lc)
+			}
+		})
+	}
+}
+
+func TestContrastInvalidColor(t *testing.T) {
+	t.Parallel()
+	if _, err := theme.ContrastRatio("212", "#ffffff"); err == nil {
+		t.Errorf("expected an error for a non hex color")
+	}
+}
+
+func TestAuditAA(t *testing.T) {
+	t.Parallel()
+	pairings, err := theme.Default().Audit()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, p := range pairings {
+		if !p.AA() {
+			t.Errorf("%s: %s on %s has a contrast ratio of %.2f:1, below WCAG AA", p.Name, p.Foreground, p.Background, p.Ratio)
+		}
+	}
+}
+
+func TestAuditDetectsLowContrast(t *testing.T) {
+	t.Parallel()
+	th := theme.Default()
+	th.H1 = th.H1.Background(lipgloss.AdaptiveColor{Light: string(theme.S50), Dark: string(theme.S200)})
+
+	pairings, err := th.Audit()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, p := range pairings {
+		if p.Name == "H1 (light)" {
+			if p.AA() {
+				t.Errorf("expected white on %s to fail WCAG AA, got %.2f:1", p.Background, p.Ratio)
+			}
+			return
+		}
+	}
+	t.Errorf("expected an audit of H1 against a light terminal")
+}
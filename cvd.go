@@ -0,0 +1,189 @@
+package theme
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// Deficiency defines a type of color vision deficiency
+type Deficiency int
+
+const (
+	// Protanopia is the absence of red sensitive cones, making red and green
+	// hard to distinguish, with red appearing darker
+	Protanopia Deficiency = iota
+
+	// Deuteranopia is the absence of green sensitive cones, making red and green
+	// hard to distinguish
+	Deuteranopia
+
+	// Tritanopia is the absence of blue sensitive cones, making blue and green,
+	// as well as yellow and violet, hard to distinguish
+	Tritanopia
+)
+
+// String returns the name of the deficiency
+func (d Deficiency) String() string {
+	switch d {
+	case Protanopia:
+		return "protanopia"
+	case Deuteranopia:
+		return "deuteranopia"
+	case Tritanopia:
+		return "tritanopia"
+	}
+	return fmt.Sprintf("Deficiency(%d)", int(d))
+}
+
+// Simulation matrices for a full severity of each deficiency, applied to linear RGB.
+// Based on the model by Machado, Oliveira and Fernandes (2009)
+var deficiencies = map[Deficiency][3][3]float64{
+	Protanopia: {
+		{0.152286, 1.052583, -0.204868},
+		{0.114503, 0.786281, 0.099216},
+		{-0.003882, -0.048116, 1.051998},
+	},
+	Deuteranopia: {
+		{0.367322, 0.860646, -0.227968},
+		{0.280085, 0.672501, 0.047413},
+		{-0.011820, 0.042940, 0.968881},
+	},
+	Tritanopia: {
+		{1.255528, -0.076749, -0.178779},
+		{-0.078411, 0.930809, 0.147602},
+		{0.004733, 0.691367, 0.303900},
+	},
+}
+
+// SimulateColor simulates how a hex color is perceived by someone with the given
+// color vision deficiency
+//
+//	theme.SimulateColor(theme.Green700, theme.Deuteranopia)
+func SimulateColor(c lipgloss.Color, d Deficiency) (lipgloss.Color, error) {
+	m, ok := deficiencies[d]
+	if !ok {
+		return "", fmt.Errorf("simulate: unsupported deficiency %s", d)
+	}
+
+	col, err := colorful.Hex(string(c))
+	if err != nil {
+		return "", fmt.Errorf("simulate: expected a hex color, got %q", string(c))
+	}
+
+	r, g, b := col.LinearRgb()
+	sim := colorful.LinearRgb(
+		m[0][0]*r+m[0][1]*g+m[0][2]*b,
+		m[1][0]*r+m[1][1]*g+m[1][2]*b,
+		m[2][0]*r+m[2][1]*g+m[2][2]*b,
+	)
+	return lipgloss.Color(sim.Clamped().Hex()), nil
+}
+
+// Simulate returns a copy of the palette, with every color shade simulated for
+// the given color vision deficiency. Any empty shade is left as is. A full theme
+// can then be created from it, previewing how it is perceived
+//
+//	th := theme.NewTheme(theme.PurpleClay.Simulate(theme.Protanopia))
+func (p Palette) Simulate(d Deficiency) (Palette, error) {
+	v := reflect.ValueOf(&p).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+
+		c := f.Interface().(lipgloss.Color)
+		if c == "" {
+			continue
+		}
+
+		sim, err := SimulateColor(c, d)
+		if err != nil {
+			return Palette{}, fmt.Errorf("%s: %w", v.Type().Field(i).Name, err)
+		}
+		f.Set(reflect.ValueOf(sim))
+	}
+	return p, nil
+}
+
+// Simulate returns a copy of the tokens, with both colors of every token simulated
+// for the given color vision deficiency. Any empty color is left as is. Needed to
+// preview a theme with tokens that are not derived from its palette
+//
+//	tk, _ := theme.ColorBlindSafeTokens(th.Tokens).Simulate(theme.Protanopia)
+func (tk Tokens) Simulate(d Deficiency) (Tokens, error) {
+	v := reflect.ValueOf(&tk).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+
+		c := f.Interface().(lipgloss.AdaptiveColor)
+		for _, shade := range []*string{&c.Light, &c.Dark} {
+			if *shade == "" {
+				continue
+			}
+
+			sim, err := SimulateColor(lipgloss.Color(*shade), d)
+			if err != nil {
+				return Tokens{}, fmt.Errorf("%s: %w", v.Type().Field(i).Name, err)
+			}
+			*shade = string(sim)
+		}
+		f.Set(reflect.ValueOf(c))
+	}
+	return tk, nil
+}
+
+// Okabe-Ito accents that remain distinguishable for all common color vision
+// deficiencies
+const (
+	okabeItoBlue       = "#0072b2"
+	okabeItoVermillion = "#d55e00"
+	okabeItoOchre      = "#e69f00"
+)
+
+// ColorBlindSafeTokens remaps the Success, Warning and Danger tokens onto hues that
+// remain distinguishable for all common color vision deficiencies. Based on the
+// Okabe-Ito palette, success becomes blue, warning ochre and danger vermillion.
+// Each token keeps the lightness of the shade it replaces, retaining its contrast
+// against the background of the terminal. All other tokens are left untouched
+func ColorBlindSafeTokens(tk Tokens) Tokens {
+	tk.Success = rehue(tk.Success, okabeItoBlue)
+	tk.Warning = rehue(tk.Warning, okabeItoOchre)
+	tk.Danger = rehue(tk.Danger, okabeItoVermillion)
+	return tk
+}
+
+// rehue replaces the hue and chroma of both colors of an adaptive color with
+// those of the given hex color, keeping their original lightness. Blending
+// within HCL keeps each color perceptually even
+func rehue(c lipgloss.AdaptiveColor, hex string) lipgloss.AdaptiveColor {
+	ref, _ := colorful.Hex(hex)
+	h, chroma, _ := ref.Hcl()
+
+	shift := func(s string) string {
+		col, err := colorful.Hex(s)
+		if err != nil {
+			return s
+		}
+
+		_, _, l := col.Hcl()
+		return colorful.Hcl(h, chroma, l).Clamped().Hex()
+	}
+	return lipgloss.AdaptiveColor{Light: shift(c.Light), Dark: shift(c.Dark)}
+}
+
+// ColorBlindSafe returns a copy of the theme, with its tokens remapped by
+// [ColorBlindSafeTokens]. Glyphs such as [Tick] and [Cross] will no longer be
+// distinguished by hue alone. The palette of the theme is left untouched
+//
+//	th := theme.NewHighContrastTheme(theme.PurpleClay).ColorBlindSafe()
+func (th *Theme) ColorBlindSafe() *Theme {
+	return th.WithTokens(ColorBlindSafeTokens(th.Tokens))
+}
+
+// ColorBlindSafeTheme returns a new instance of the PurpleClay theme, with tokens
+// that remain distinguishable for all common color vision deficiencies. See
+// [Theme.ColorBlindSafe]
+func ColorBlindSafeTheme() *Theme {
+	return NewTheme(PurpleClay).ColorBlindSafe()
+}
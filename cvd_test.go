@@ -0,0 +1,157 @@
+package theme_test
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
+	theme "github.com/purpleclay/lipgloss-theme"
+)
+
+var deficiencies = []theme.Deficiency{theme.Protanopia, theme.Deuteranopia, theme.Tritanopia}
+
+func TestSimulateColor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		deficiency theme.Deficiency
+		color      lipgloss.Color
+		expected   lipgloss.Color
+	}{
+		{name: "White", deficiency: theme.Protanopia, color: "#ffffff", expected: "#ffffff"},
+		{name: "Black", deficiency: theme.Tritanopia, color: "#000000", expected: "#000000"},
+		{name: "GreyIsUnchanged", deficiency: theme.Deuteranopia, color: "#808080", expected: "#808080"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sim, err := theme.SimulateColor(tt.color, tt.deficiency)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if sim != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, sim)
+			}
+		})
+	}
+}
+
+func TestSimulateColorInvalid(t *testing.T) {
+	t.Parallel()
+	if _, err := theme.SimulateColor("212", theme.Protanopia); err == nil {
+		t.Errorf("expected an error for a non hex color")
+	}
+
+	if _, err := theme.SimulateColor("#ffffff", theme.Deficiency(99)); err == nil {
+		t.Errorf("expected an error for an unsupported deficiency")
+	}
+}
+
+func TestPaletteSimulate(t *testing.T) {
+	t.Parallel()
+	for _, d := range deficiencies {
+		t.Run(d.String(), func(t *testing.T) {
+			p, err := theme.PurpleClay.Simulate(d)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			expected, _ := theme.SimulateColor(theme.Green700, d)
+			if p.Green700 != expected {
+				t.Errorf("expected Green700 to be simulated as %s, got %s", expected, p.Green700)
+			}
+		})
+	}
+}
+
+func TestPaletteSimulatePartial(t *testing.T) {
+	t.Parallel()
+	p, err := gotham.Simulate(theme.Deuteranopia)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if p.Green50 != "" {
+		t.Errorf("expected an empty shade to be left as is, got %s", p.Green50)
+	}
+
+	expected, _ := theme.SimulateColor(gotham.Green600, theme.Deuteranopia)
+	if p.Green600 != expected {
+		t.Errorf("expected Green600 to be simulated as %s, got %s", expected, p.Green600)
+	}
+}
+
+func TestColorBlindSafeTheme(t *testing.T) {
+	t.Parallel()
+	th := theme.ColorBlindSafeTheme()
+
+	pairs := map[string][2]lipgloss.Color{
+		"light": {lipgloss.Color(th.Tokens.Success.Light), lipgloss.Color(th.Tokens.Danger.Light)},
+		"dark":  {lipgloss.Color(th.Tokens.Success.Dark), lipgloss.Color(th.Tokens.Danger.Dark)},
+	}
+
+	for _, d := range deficiencies {
+		for name, pair := range pairs {
+			tick, _ := theme.SimulateColor(pair[0], d)
+			cross, _ := theme.SimulateColor(pair[1], d)
+
+			// A CIEDE2000 difference above 30 is clearly distinguishable
+			if diff := colorDistance(tick, cross); diff < 30 {
+				t.Errorf("%s (%s): expected tick and cross to be distinguishable, got a difference of %.1f", d, name, diff)
+			}
+		}
+	}
+
+	pairings, err := th.Audit()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, p := range pairings {
+		if !p.AA() {
+			t.Errorf("%s: %s on %s has a contrast ratio of %.2f:1, below WCAG AA", p.Name, p.Foreground, p.Background, p.Ratio)
+		}
+	}
+}
+
+func TestColorBlindSafeKeepsPalette(t *testing.T) {
+	t.Parallel()
+	th := theme.NewHighContrastTheme(theme.PurpleClay)
+	safe := th.ColorBlindSafe()
+
+	if safe.Palette != theme.PurpleClay {
+		t.Errorf("expected the PurpleClay palette to be left untouched")
+	}
+
+	if safe.H1.GetBackground() != th.H1.GetBackground() {
+		t.Errorf("expected H1 to keep the background of the high contrast theme")
+	}
+
+	if safe.Tokens.Info != th.Tokens.Info || safe.Tokens.Link != th.Tokens.Link {
+		t.Errorf("expected only the success, warning and danger tokens to change")
+	}
+
+	if safe.Tokens.Success == th.Tokens.Success {
+		t.Errorf("expected the success token to be remapped")
+	}
+}
+
+func TestTokensSimulate(t *testing.T) {
+	t.Parallel()
+	tk, err := theme.NewTokens(theme.PurpleClay).Simulate(theme.Deuteranopia)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected, _ := theme.SimulateColor(lipgloss.Color(theme.NewTokens(theme.PurpleClay).Success.Dark), theme.Deuteranopia)
+	if tk.Success.Dark != string(expected) {
+		t.Errorf("expected the dark success token to be simulated as %s, got %s", expected, tk.Success.Dark)
+	}
+}
+
+func colorDistance(a, b lipgloss.Color) float64 {
+	ca, _ := colorful.Hex(string(a))
+	cb, _ := colorful.Hex(string(b))
+	return ca.DistanceCIEDE2000(cb) * 100
+}
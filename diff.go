@@ -0,0 +1,151 @@
+package theme
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// change defines how a cell within a diff table differs between snapshots
+type change int
+
+const (
+	rowAdded change = iota + 1
+	rowRemoved
+	cellChanged
+)
+
+// NewDiffTable creates a table that visualizes the differences between two
+// snapshots of tabular data. Rows are matched between snapshots using the value
+// of the key column. The first row of each snapshot is treated as a header. Any
+// added rows are rendered in Green700, removed rows in Red700 with a strikethrough,
+// and changed cells are highlighted using [Theme.Mark]. A cell that has been
+// cleared shows its old value with a strikethrough. Removed rows retain their
+// original position relative to the rows that remain. Styles are taken from the
+// theme of the table when rendered. Keys are expected to be unique within each
+// snapshot, with any duplicates or a key outside of the columns of the table
+// reported by [Table.Validate]
+//
+//	before := [][]string{{"ID", "State"}, {"1", "pending"}, {"2", "pending"}}
+//	after := [][]string{{"ID", "State"}, {"1", "applied"}, {"3", "pending"}}
+//	theme.NewDiffTable(before, after, 0)
+func NewDiffTable(before, after [][]string, key int) *Table {
+	if len(after) == 0 {
+		return NewTable(after)
+	}
+
+	header := after[0]
+	cols := len(header)
+	if key < 0 || key >= cols {
+		t := NewTable(after)
+		t.invalid("diff", "key %d: expected a column between 0 and %d", key, cols-1)
+		return t
+	}
+
+	var dupes []string
+	beforeRows := map[string]int{}
+	for i := 1; i < len(before); i++ {
+		k := diffCell(before[i], key)
+		if _, ok := beforeRows[k]; ok {
+			dupes = append(dupes, fmt.Sprintf("before: row %d: duplicate key %q", i, k))
+		}
+		beforeRows[k] = i
+	}
+
+	afterRows := map[string]bool{}
+	for i := 1; i < len(after); i++ {
+		k := diffCell(after[i], key)
+		if afterRows[k] {
+			dupes = append(dupes, fmt.Sprintf("after: row %d: duplicate key %q", i, k))
+		}
+		afterRows[k] = true
+	}
+
+	data := [][]string{header}
+	changes := map[cellPos]change{}
+	cleared := map[cellPos]string{}
+	diffRow := func(row []string, c change) {
+		for j := 0; j < cols; j++ {
+			changes[cellPos{row: len(data), col: j}] = c
+		}
+		data = append(data, padRow(row, cols))
+	}
+
+	next := 1
+	flushRemoved := func(upTo int) {
+		for ; next <= upTo && next < len(before); next++ {
+			if !afterRows[diffCell(before[next], key)] {
+				diffRow(before[next], rowRemoved)
+			}
+		}
+	}
+
+	for i := 1; i < len(after); i++ {
+		prev, ok := beforeRows[diffCell(after[i], key)]
+		if !ok {
+			diffRow(after[i], rowAdded)
+			continue
+		}
+
+		flushRemoved(prev)
+		for j := 0; j < cols; j++ {
+			if diffCell(after[i], j) != diffCell(before[prev], j) {
+				pos := cellPos{row: len(data), col: j}
+				changes[pos] = cellChanged
+				if diffCell(after[i], j) == "" {
+					cleared[pos] = diffCell(before[prev], j)
+				}
+			}
+		}
+		data = append(data, padRow(after[i], cols))
+	}
+	flushRemoved(len(before) - 1)
+
+	t := NewTable(data)
+	t.changes = changes
+	t.cleared = cleared
+	for _, d := range dupes {
+		t.invalid("diff", "%s", d)
+	}
+	return t
+}
+
+func diffCell(row []string, col int) string {
+	if col >= len(row) {
+		return ""
+	}
+	return row[col]
+}
+
+func padRow(row []string, cols int) []string {
+	padded := make([]string, cols)
+	for i := range padded {
+		padded[i] = diffCell(row, i)
+	}
+	return padded
+}
+
+// changeStyle returns the style used to render a cell that differs between the
+// snapshots of a diff table. Without color, added rows are rendered in bold. A
+// cleared cell is struck through, so its old value remains visible
+func (t *Table) changeStyle(row, col int) (lipgloss.Style, bool) {
+	th := t.theme
+	switch t.changes[cellPos{row: row, col: col}] {
+	case rowAdded:
+		if th.noColor {
+			return th.B, true
+		}
+		return th.renderer.NewStyle().Foreground(Complete(th.Palette.Green700)), true
+	case rowRemoved:
+		if th.noColor {
+			return th.S, true
+		}
+		return th.S.Foreground(Complete(th.Palette.Red700)), true
+	case cellChanged:
+		if _, ok := t.cleared[cellPos{row: row, col: col}]; ok {
+			return th.Mark.UnsetPadding().Strikethrough(true), true
+		}
+		return th.Mark.UnsetPadding(), true
+	}
+	return lipgloss.Style{}, false
+}
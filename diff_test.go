@@ -0,0 +1,77 @@
+package theme_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/muesli/termenv"
+	theme "github.com/purpleclay/lipgloss-theme"
+)
+
+func TestDiffTable(t *testing.T) {
+	before := [][]string{
+		{"Name", "Alias", "Status"},
+		{"Bruce Wayne", "Batman", "Active"},
+		{"Dick Grayson", "Robin", "Active"},
+		{"Barbara Gordon", "Batgirl", "Active"},
+		{"Alfred Pennyworth", "Agent A", "Retired"},
+	}
+
+	after := [][]string{
+		{"Name", "Alias", "Status"},
+		{"Bruce Wayne", "Batman", "Active"},
+		{"Dick Grayson", "Nightwing", "Active"},
+		{"Alfred Pennyworth", "", "Active"},
+		{"Jason Todd", "Red Hood", "Rogue"},
+	}
+
+	// Render in color, so added, removed and changed cells are captured
+	r := lipgloss.NewRenderer(io.Discard)
+	r.SetColorProfile(termenv.TrueColor)
+	r.SetHasDarkBackground(true)
+
+	tbl := theme.NewDiffTable(before, after, 0).
+		Border(theme.ThinBorder).
+		Theme(theme.NewThemeWithRenderer(r, theme.PurpleClay))
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
+func TestDiffTableDuplicateKeys(t *testing.T) {
+	before := [][]string{
+		{"Name", "Alias"},
+		{"Bruce Wayne", "Batman"},
+		{"Bruce Wayne", "Matches Malone"},
+	}
+
+	after := [][]string{
+		{"Name", "Alias"},
+		{"Dick Grayson", "Robin"},
+		{"Dick Grayson", "Nightwing"},
+	}
+
+	err := theme.NewDiffTable(before, after, 0).Validate()
+	for _, expected := range []string{
+		`diff: before: row 2: duplicate key "Bruce Wayne"`,
+		`diff: after: row 2: duplicate key "Dick Grayson"`,
+	} {
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error containing %q, got %v", expected, err)
+		}
+	}
+}
+
+func TestDiffTableKeyOutOfRange(t *testing.T) {
+	before := [][]string{{"Name", "Alias"}, {"Bruce Wayne", "Batman"}}
+	after := [][]string{{"Name", "Alias"}, {"Bruce Wayne", "Batman"}}
+
+	err := theme.NewDiffTable(before, after, 2).Validate()
+
+	expected := "diff: key 2: expected a column between 0 and 1"
+	if err == nil || !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected error containing %q, got %v", expected, err)
+	}
+}
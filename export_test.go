@@ -0,0 +1,8 @@
+package theme
+
+// ResetDefault rebuilds the theme that all package level styles are aliases of,
+// from the current environment. The default theme is built when the package is
+// initialized, before any test can isolate itself from the environment
+func ResetDefault() {
+	setDefault(Default())
+}
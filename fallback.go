@@ -0,0 +1,115 @@
+package theme
+
+import (
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+type fallback struct {
+	ansi256 string
+	ansi    string
+}
+
+// fallbacks defines the hand-picked ANSI256 and ANSI colors used by every color
+// of the PurpleClay palette, when the terminal does not support true color.
+// Automatic downsampling would otherwise collapse several shades into the same
+// color, with headers H1 to H6 no longer distinguishable. Each shade of purple
+// maps to its own ANSI256 color, darkening with the shade. With only 16 colors,
+// the darkest shades share black, see [NewHighContrastTheme]
+var fallbacks = map[lipgloss.Color]fallback{
+	S950: {ansi256: "53", ansi: "0"},
+	S900: {ansi256: "54", ansi: "0"},
+	S800: {ansi256: "55", ansi: "0"},
+	S700: {ansi256: "90", ansi: "0"},
+	S600: {ansi256: "56", ansi: "8"},
+	S500: {ansi256: "91", ansi: "4"},
+	S400: {ansi256: "57", ansi: "12"},
+	S300: {ansi256: "92", ansi: "5"},
+	S200: {ansi256: "93", ansi: "13"},
+	S100: {ansi256: "98", ansi: "13"},
+	S50:  {ansi256: "140", ansi: "13"},
+
+	Green950: {ansi256: "22", ansi: "2"},
+	Green900: {ansi256: "28", ansi: "2"},
+	Green800: {ansi256: "29", ansi: "2"},
+	Green700: {ansi256: "35", ansi: "2"},
+	Green600: {ansi256: "34", ansi: "2"},
+	Green500: {ansi256: "41", ansi: "2"},
+	Green400: {ansi256: "42", ansi: "10"},
+	Green300: {ansi256: "78", ansi: "10"},
+	Green200: {ansi256: "121", ansi: "10"},
+	Green100: {ansi256: "158", ansi: "10"},
+	Green50:  {ansi256: "194", ansi: "10"},
+
+	Amber950: {ansi256: "58", ansi: "3"},
+	Amber900: {ansi256: "94", ansi: "3"},
+	Amber800: {ansi256: "130", ansi: "3"},
+	Amber700: {ansi256: "166", ansi: "3"},
+	Amber600: {ansi256: "202", ansi: "3"},
+	Amber500: {ansi256: "208", ansi: "3"},
+	Amber400: {ansi256: "214", ansi: "11"},
+	Amber300: {ansi256: "215", ansi: "11"},
+	Amber200: {ansi256: "222", ansi: "11"},
+	Amber100: {ansi256: "223", ansi: "11"},
+	Amber50:  {ansi256: "230", ansi: "11"},
+
+	Red950: {ansi256: "52", ansi: "1"},
+	Red900: {ansi256: "88", ansi: "1"},
+	Red800: {ansi256: "124", ansi: "1"},
+	Red700: {ansi256: "160", ansi: "1"},
+	Red600: {ansi256: "196", ansi: "1"},
+	Red500: {ansi256: "197", ansi: "1"},
+	Red400: {ansi256: "203", ansi: "9"},
+	Red300: {ansi256: "204", ansi: "9"},
+	Red200: {ansi256: "210", ansi: "9"},
+	Red100: {ansi256: "217", ansi: "9"},
+	Red50:  {ansi256: "224", ansi: "9"},
+
+	Blue950: {ansi256: "17", ansi: "4"},
+	Blue900: {ansi256: "24", ansi: "4"},
+	Blue800: {ansi256: "25", ansi: "4"},
+	Blue700: {ansi256: "26", ansi: "4"},
+	Blue600: {ansi256: "68", ansi: "4"},
+	Blue500: {ansi256: "33", ansi: "4"},
+	Blue400: {ansi256: "75", ansi: "12"},
+	Blue300: {ansi256: "117", ansi: "12"},
+	Blue200: {ansi256: "153", ansi: "12"},
+	Blue100: {ansi256: "189", ansi: "12"},
+	Blue50:  {ansi256: "195", ansi: "12"},
+}
+
+// Complete returns a color with ANSI256 and ANSI fallbacks for terminals that do not
+// support true color. Colors of the PurpleClay palette use hand-picked fallbacks,
+// while any other hex color is downsampled automatically
+func Complete(c lipgloss.Color) lipgloss.CompleteColor {
+	if f, ok := fallbacks[c]; ok {
+		return lipgloss.CompleteColor{TrueColor: string(c), ANSI256: f.ansi256, ANSI: f.ansi}
+	}
+
+	return lipgloss.CompleteColor{
+		TrueColor: string(c),
+		ANSI256:   downsample(termenv.ANSI256, c),
+		ANSI:      downsample(termenv.ANSI, c),
+	}
+}
+
+// CompleteAdaptive returns an adaptive color with ANSI256 and ANSI fallbacks for
+// terminals that do not support true color. See [Complete]
+func CompleteAdaptive(c lipgloss.AdaptiveColor) lipgloss.CompleteAdaptiveColor {
+	return lipgloss.CompleteAdaptiveColor{
+		Light: Complete(lipgloss.Color(c.Light)),
+		Dark:  Complete(lipgloss.Color(c.Dark)),
+	}
+}
+
+func downsample(p termenv.Profile, c lipgloss.Color) string {
+	switch col := p.Color(string(c)).(type) {
+	case termenv.ANSI256Color:
+		return strconv.Itoa(int(col))
+	case termenv.ANSIColor:
+		return strconv.Itoa(int(col))
+	}
+	return ""
+}
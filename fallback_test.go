@@ -0,0 +1,131 @@
+package theme_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	theme "github.com/purpleclay/lipgloss-theme"
+)
+
+func TestHeadersPerProfile(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		profile  termenv.Profile
+		expected []string
+	}{
+		{
+			name:    "TrueColor",
+			profile: termenv.TrueColor,
+			expected: []string{
+				"48;2;103;36;183m", "48;2;87;18;171m", "48;2;72;0;159m",
+				"48;2;60;0;135m", "48;2;51;0;111m", "48;2;40;0;87m",
+			},
+		},
+		{
+			name:     "ANSI256",
+			profile:  termenv.ANSI256,
+			expected: []string{"48;5;93m", "48;5;92m", "48;5;57m", "48;5;91m", "48;5;56m", "48;5;90m"},
+		},
+		{
+			name:     "ANSI",
+			profile:  termenv.ANSI,
+			expected: []string{"105m", "45m", "104m", "44m", "100m", "40m"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := lipgloss.NewRenderer(io.Discard)
+			r.SetColorProfile(tt.profile)
+			th := theme.NewThemeWithRenderer(r, theme.PurpleClay)
+
+			headers := []lipgloss.Style{th.H1, th.H2, th.H3, th.H4, th.H5, th.H6}
+			for i, h := range headers {
+				if out := h.Render("Gotham"); !strings.Contains(out, tt.expected[i]) {
+					t.Errorf("H%d: expected %q to be rendered with %q", i+1, out, tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestHighContrastHeadersDistinct(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		profile termenv.Profile
+		dark    bool
+	}{
+		{name: "ANSI256", profile: termenv.ANSI256},
+		{name: "ANSI", profile: termenv.ANSI},
+		{name: "ANSI256Dark", profile: termenv.ANSI256, dark: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := lipgloss.NewRenderer(io.Discard)
+			r.SetColorProfile(tt.profile)
+			r.SetHasDarkBackground(tt.dark)
+			th := theme.NewHighContrastThemeWithRenderer(r, theme.PurpleClay)
+
+			seen := map[string]int{}
+			for i, h := range []lipgloss.Style{th.H1, th.H2, th.H3, th.H4, th.H5, th.H6} {
+				out := h.Render("Gotham")
+				if prev, ok := seen[out]; ok {
+					t.Errorf("expected H%d and H%d to be distinguishable, both rendered as %q", prev, i+1, out)
+				}
+				seen[out] = i + 1
+			}
+		})
+	}
+}
+
+func TestHeadersAsciiProfile(t *testing.T) {
+	t.Parallel()
+	r := lipgloss.NewRenderer(io.Discard)
+	r.SetColorProfile(termenv.Ascii)
+	th := theme.NewThemeWithRenderer(r, theme.PurpleClay)
+
+	for i, h := range []lipgloss.Style{th.H1, th.H2, th.H3, th.H4, th.H5, th.H6} {
+		if out := h.Render("Gotham"); strings.Contains(out, "\x1b[") {
+			t.Errorf("H%d: expected no color, got %q", i+1, out)
+		}
+	}
+}
+
+func TestPaletteFallbacksDistinct(t *testing.T) {
+	t.Parallel()
+	p := theme.PurpleClay
+	scales := map[string][]lipgloss.Color{
+		"S":     {p.S50, p.S100, p.S200, p.S300, p.S400, p.S500, p.S600, p.S700, p.S800, p.S900, p.S950},
+		"Green": {p.Green50, p.Green100, p.Green200, p.Green300, p.Green400, p.Green500, p.Green600, p.Green700, p.Green800, p.Green900, p.Green950},
+		"Amber": {p.Amber50, p.Amber100, p.Amber200, p.Amber300, p.Amber400, p.Amber500, p.Amber600, p.Amber700, p.Amber800, p.Amber900, p.Amber950},
+		"Red":   {p.Red50, p.Red100, p.Red200, p.Red300, p.Red400, p.Red500, p.Red600, p.Red700, p.Red800, p.Red900, p.Red950},
+		"Blue":  {p.Blue50, p.Blue100, p.Blue200, p.Blue300, p.Blue400, p.Blue500, p.Blue600, p.Blue700, p.Blue800, p.Blue900, p.Blue950},
+	}
+
+	for name, scale := range scales {
+		seen := map[string]lipgloss.Color{}
+		for _, c := range scale {
+			f := theme.Complete(c).ANSI256
+			if prev, ok := seen[f]; ok {
+				t.Errorf("%s: expected %s and %s to have distinct ANSI256 fallbacks, got %s", name, prev, c, f)
+			}
+			seen[f] = c
+		}
+	}
+}
+
+func TestCompleteDownsample(t *testing.T) {
+	t.Parallel()
+	c := theme.Complete("#ff0000")
+
+	expected := lipgloss.CompleteColor{TrueColor: "#ff0000", ANSI256: "196", ANSI: "9"}
+	if c != expected {
+		t.Errorf("expected %v, got %v", expected, c)
+	}
+}
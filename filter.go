@@ -0,0 +1,125 @@
+package theme
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Filter keeps all rows within the table that satisfy the predicate, removing
+// everything else. The first row of the table is treated as a header and is
+// always kept
+//
+//	theme.NewTable(data).Filter(func(row []string) bool {
+//		return row[1] == "Female"
+//	})
+func (t *Table) Filter(keep func(row []string) bool) *Table {
+	for i := len(t.data) - 1; i > 0; i-- {
+		if !keep(t.data[i]) {
+			t.DeleteRow(i)
+		}
+	}
+	return t
+}
+
+// Search keeps all rows within the table that contain the given text in any
+// of their cells, highlighting each match using the [Theme.Mark] style. The
+// first row of the table is treated as a header and is always kept. Matches
+// are highlighted when rendered, leaving the data of the table untouched, so
+// searches can be chained
+func (t *Table) Search(text string) *Table {
+	if text == "" {
+		return t
+	}
+	return t.SearchRegexp(regexp.MustCompile(regexp.QuoteMeta(text)))
+}
+
+// SearchRegexp keeps all rows within the table that match the regular expression
+// in any of their cells, highlighting each match using the [Theme.Mark] style.
+// The first row of the table is treated as a header and is always kept
+//
+//	theme.NewTable(data).SearchRegexp(regexp.MustCompile(`(?i)clown`))
+func (t *Table) SearchRegexp(re *regexp.Regexp) *Table {
+	t.Filter(func(row []string) bool {
+		for _, c := range row {
+			if re.MatchString(c) {
+				return true
+			}
+		}
+		return false
+	})
+
+	for i := 1; i < len(t.data); i++ {
+		for j, c := range t.data[i] {
+			pos := cellPos{row: i, col: j}
+			if _, ok := t.nested[pos]; ok {
+				continue
+			}
+
+			if matches := re.FindAllStringIndex(c, -1); len(matches) > 0 {
+				if t.matches == nil {
+					t.matches = map[cellPos][][]int{}
+				}
+				t.matches[pos] = mergeMatches(append(t.matches[pos], matches...))
+			}
+		}
+	}
+	return t
+}
+
+// mergeMatches sorts and merges any overlapping matches, from one or more
+// searches, so each part of a cell is highlighted only once
+func mergeMatches(matches [][]int) [][]int {
+	slices.SortFunc(matches, func(a, b []int) int {
+		return a[0] - b[0]
+	})
+
+	merged := make([][]int, 0, len(matches))
+	for _, m := range matches {
+		if n := len(merged); n > 0 && m[0] <= merged[n-1][1] {
+			merged[n-1] = []int{merged[n-1][0], max(merged[n-1][1], m[1])}
+			continue
+		}
+		merged = append(merged, m)
+	}
+	return merged
+}
+
+// highlight renders each match within the text using the mark style. As a cell
+// may wrap at any whitespace, only the words within a match are highlighted,
+// ensuring no highlighting bleeds across wrapped lines
+func highlight(mark lipgloss.Style, str string, matches [][]int) string {
+	mark = mark.UnsetPadding()
+
+	var b strings.Builder
+	prev := 0
+	for _, m := range matches {
+		if m[0] == m[1] {
+			continue
+		}
+
+		b.WriteString(str[prev:m[0]])
+		match := str[m[0]:m[1]]
+		for len(match) > 0 {
+			end := strings.IndexFunc(match, unicode.IsSpace)
+			if end == -1 {
+				end = len(match)
+			}
+
+			if end > 0 {
+				b.WriteString(mark.Render(match[:end]))
+			}
+
+			space := len(match[end:]) - len(strings.TrimLeftFunc(match[end:], unicode.IsSpace))
+			b.WriteString(match[end : end+space])
+			match = match[end+space:]
+		}
+		prev = m[1]
+	}
+
+	b.WriteString(str[prev:])
+	return b.String()
+}
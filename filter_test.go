@@ -0,0 +1,99 @@
+package theme_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/muesli/termenv"
+	theme "github.com/purpleclay/lipgloss-theme"
+)
+
+func TestTableFilter(t *testing.T) {
+	t.Parallel()
+	tbl := theme.NewTable(data).
+		Filter(func(row []string) bool {
+			return row[3] == "8"
+		})
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
+func TestTableSearch(t *testing.T) {
+	t.Parallel()
+	tbl := theme.NewTable(data).Search("green")
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
+func TestTableSearchRegexp(t *testing.T) {
+	t.Parallel()
+	tbl := theme.NewTable(data).SearchRegexp(regexp.MustCompile(`(?i)^mad|obsession with \w+`))
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
+func TestTableSearchNoMatches(t *testing.T) {
+	t.Parallel()
+	tbl := theme.NewTable(data).Search("Batman")
+
+	if got, want := tbl.String(), theme.NewTable(data[:1]).String(); got != want {
+		t.Errorf("expected only the header to be kept, got:\n%s", got)
+	}
+}
+
+func TestTableSearchHighlightWrapping(t *testing.T) {
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	defer lipgloss.SetColorProfile(termenv.Ascii)
+
+	tbl := theme.NewTable(data).
+		Widths(20).
+		Search("Clown-like appearance, green hair")
+
+	var highlighted int
+	for _, line := range strings.Split(tbl.String(), "\n") {
+		if !strings.Contains(line, "\x1b[") {
+			continue
+		}
+		highlighted++
+
+		// Every highlight must be reset on the line it starts, otherwise it
+		// would bleed into the borders of the table
+		resets := strings.Count(line, "\x1b[0m")
+		if opens := strings.Count(line, "\x1b[") - resets; opens != resets {
+			t.Errorf("expected highlight to be reset on the same line, got: %q", line)
+		}
+	}
+
+	if highlighted < 2 {
+		t.Errorf("expected highlight to wrap across multiple lines, got %d", highlighted)
+	}
+}
+
+func TestTableSearchChained(t *testing.T) {
+	lipgloss.SetColorProfile(termenv.ANSI256)
+	defer lipgloss.SetColorProfile(termenv.Ascii)
+
+	tbl := theme.NewTable(data).
+		Search("green").
+		Search("m").
+		Filter(func(row []string) bool {
+			for _, c := range row {
+				if strings.Contains(c, "\x1b") {
+					t.Errorf("expected filter to receive unstyled data, got %q", c)
+				}
+			}
+			return true
+		})
+
+	out := tbl.String()
+	if stray := regexp.MustCompile(`\x1b\[[0-9;]*m`).ReplaceAllString(out, ""); strings.Contains(stray, "\x1b") {
+		t.Errorf("expected only well formed escape codes, got %q", out)
+	}
+
+	if !strings.Contains(out, "\x1b[48;5;") {
+		t.Errorf("expected matches to be highlighted, got %q", out)
+	}
+}
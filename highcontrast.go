@@ -0,0 +1,94 @@
+package theme
+
+import (
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// HighContrastEnabled inspects the environment to determine whether the high
+// contrast variant of a theme has been requested, by setting PURPLECLAY_CONTRAST
+// to high
+func HighContrastEnabled() bool {
+	return strings.EqualFold(os.Getenv("PURPLECLAY_CONTRAST"), "high")
+}
+
+// HighContrastTheme returns a new instance of the high contrast variant of the
+// PurpleClay theme
+func HighContrastTheme() *Theme {
+	return NewHighContrastTheme(PurpleClay)
+}
+
+// NewHighContrastTheme creates a high contrast variant of a theme from the given
+// palette. Headers, links, borders and glyphs are drawn with the darkest shades on
+// a light terminal and the lightest shades on a dark terminal, maximising their
+// contrast against a pure white or black background. On a dark terminal, headers
+// use light tints of the palette with black text. Every header background has a
+// contrast ratio of at least 4.5:1 against the terminal, beyond the 3:1 required
+// of non-text content by WCAG AA. Without true color, headers on a light terminal
+// fall back to their own ANSI color
+func NewHighContrastTheme(p Palette) *Theme {
+	return NewHighContrastThemeWithRenderer(lipgloss.DefaultRenderer(), p)
+}
+
+// NewHighContrastThemeWithRenderer creates a high contrast variant of a theme from
+// the given palette, with every style bound to the renderer
+func NewHighContrastThemeWithRenderer(r *lipgloss.Renderer, p Palette) *Theme {
+	return newTheme(r, p, highContrast(p))
+}
+
+func highContrast(p Palette) variant {
+	const (
+		black = lipgloss.Color("#000000")
+		white = lipgloss.Color("#ffffff")
+	)
+
+	// As with the standard theme, contrast increases from H1 to H6. Each header
+	// stands apart from the background of both a light and dark terminal
+	light := [6]lipgloss.Color{p.S500, p.S600, p.S700, p.S800, p.S900, p.S950}
+	dark := [6]float64{0.25, 0.4, 0.5, 0.62, 0.72, 0.82}
+
+	// With only 16 colors, the darkest shades share black. Each header on a
+	// light terminal is given its own color, so they remain distinguishable.
+	// Downsampling would also collapse the lightest tints into the same color
+	ansi := [6]string{"13", "12", "5", "4", "8", "0"}
+	ansi256 := [6]string{"146", "182", "183", "189", "225", "255"}
+
+	var headers [6]lipgloss.TerminalColor
+	for i := range headers {
+		h := CompleteAdaptive(adaptive(light[i], tint(p.S50, dark[i])))
+		h.Light.ANSI = ansi[i]
+		h.Dark.ANSI256 = ansi256[i]
+		headers[i] = h
+	}
+
+	return variant{
+		tokens: Tokens{
+			Primary:   adaptive(p.S950, white),
+			Secondary: adaptive(p.S800, p.S50),
+			Surface:   adaptive(p.S950, white),
+			Muted:     adaptive(p.S700, p.S100),
+			Success:   adaptive(p.Green950, p.Green300),
+			Warning:   adaptive(p.Amber950, p.Amber300),
+			Danger:    adaptive(p.Red950, p.Red300),
+			Info:      adaptive(p.Blue950, p.Blue300),
+			Border:    adaptive(p.S950, white),
+			Link:      adaptive(p.S950, white),
+		},
+		headers:    headers,
+		headerText: CompleteAdaptive(adaptive(white, black)),
+		markText:   adaptive(white, black),
+	}
+}
+
+// tint blends a hex color towards white by the given amount, between 0 and 1.
+// Blending within CIELAB keeps each tint perceptually even
+func tint(c lipgloss.Color, amount float64) lipgloss.Color {
+	col, err := colorful.Hex(string(c))
+	if err != nil {
+		return c
+	}
+	return lipgloss.Color(col.BlendLab(colorful.Color{R: 1, G: 1, B: 1}, amount).Clamped().Hex())
+}
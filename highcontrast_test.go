@@ -0,0 +1,122 @@
+package theme_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+
+	theme "github.com/purpleclay/lipgloss-theme"
+)
+
+func TestHighContrastAuditAAA(t *testing.T) {
+	t.Parallel()
+	pairings, err := theme.HighContrastTheme().Audit()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, p := range pairings {
+		min := 7.0
+		if p.NonText {
+			min = 4.5
+		}
+
+		if p.Ratio < min {
+			t.Errorf("%s: %s on %s has a contrast ratio of %.2f:1, below WCAG AAA", p.Name, p.Foreground, p.Background, p.Ratio)
+		}
+	}
+}
+
+func TestHighContrastHeaderBackgrounds(t *testing.T) {
+	tests := []struct {
+		name       string
+		dark       bool
+		background lipgloss.Color
+	}{
+		{name: "Light", background: "#ffffff"},
+		{name: "Dark", dark: true, background: "#000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := lipgloss.NewRenderer(io.Discard)
+			r.SetHasDarkBackground(tt.dark)
+			th := theme.NewHighContrastThemeWithRenderer(r, theme.PurpleClay)
+
+			for i, h := range []lipgloss.Style{th.H1, th.H2, th.H3, th.H4, th.H5, th.H6} {
+				bg := h.GetBackground().(lipgloss.CompleteAdaptiveColor).Light.TrueColor
+				if tt.dark {
+					bg = h.GetBackground().(lipgloss.CompleteAdaptiveColor).Dark.TrueColor
+				}
+
+				ratio, err := theme.ContrastRatio(lipgloss.Color(bg), tt.background)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if ratio < 4.5 {
+					t.Errorf("H%d: %s on %s has a contrast ratio of %.2f:1, below 4.5:1", i+1, bg, tt.background, ratio)
+				}
+			}
+		})
+	}
+}
+
+func TestHighContrastExceedsDefault(t *testing.T) {
+	t.Parallel()
+	def, err := theme.Default().Audit()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	hc, err := theme.HighContrastTheme().Audit()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ratios := map[string]float64{}
+	for _, p := range def {
+		ratios[p.Name] = p.Ratio
+	}
+
+	for _, p := range hc {
+		if p.Ratio < ratios[p.Name] {
+			t.Errorf("%s: expected a contrast ratio of at least %.2f:1, got %.2f:1", p.Name, ratios[p.Name], p.Ratio)
+		}
+	}
+}
+
+func TestHighContrastEnabled(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{value: "", expected: false},
+		{value: "high", expected: true},
+		{value: "HIGH", expected: true},
+		{value: "low", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv("PURPLECLAY_CONTRAST", tt.value)
+			if got := theme.HighContrastEnabled(); got != tt.expected {
+				t.Errorf("expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDefaultHighContrast(t *testing.T) {
+	t.Setenv("PURPLECLAY_CONTRAST", "high")
+
+	th := theme.Default()
+	if got, expected := th.H1.GetBackground(), theme.HighContrastTheme().H1.GetBackground(); got != expected {
+		t.Errorf("expected H1 background %v, got %v", expected, got)
+	}
+
+	if got, expected := th.A.GetForeground(), theme.CompleteAdaptive(theme.HighContrastTheme().Tokens.Link); got != expected {
+		t.Errorf("expected link color %v, got %v", expected, got)
+	}
+}
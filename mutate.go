@@ -0,0 +1,266 @@
+package theme
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// AppendRow appends a row to the end of the table. Only the new row is measured,
+// with the width of each column growing to fit it if needed
+func (t *Table) AppendRow(row []string) *Table {
+	return t.InsertRow(len(t.data), row)
+}
+
+// InsertRow inserts a row into the table at the given position, shifting all
+// subsequent rows down. Only the new row is measured, with the width of each
+// column growing to fit it if needed
+func (t *Table) InsertRow(pos int, row []string) *Table {
+	option := fmt.Sprintf("insert row: row %d", pos)
+	t.resetErrors(option)
+	if pos < 0 || pos > len(t.data) {
+		t.invalid(option, "expected a row between 0 and %d", len(t.data))
+		return t
+	}
+
+	t.ownData()
+	t.data = slices.Insert(t.data, pos, slices.Clone(row))
+	if len(t.data) == 1 {
+		return t.reset()
+	}
+
+	t.shiftRows(pos, 1)
+	t.rowHeights = slices.Insert(t.rowHeights, pos, 0)
+	if t.parents != nil {
+		return t.remeasure()
+	}
+
+	t.measureRow(pos)
+	t.resetWidths()
+	return t
+}
+
+// DeleteRow removes a row from the table. Only columns whose width was
+// determined by the removed row are measured again
+func (t *Table) DeleteRow(pos int) *Table {
+	if !t.checkRow("delete row", pos) {
+		return t
+	}
+
+	t.ownData()
+	widths := make([]int, len(t.natWidths))
+	for j := range t.data[pos][:min(len(t.data[pos]), len(widths))] {
+		widths[j], _ = t.measure(pos, j)
+	}
+
+	t.data = slices.Delete(t.data, pos, pos+1)
+	if len(t.data) == 0 {
+		return t.reset()
+	}
+
+	t.shiftRows(pos, -1)
+	t.rowHeights = slices.Delete(t.rowHeights, pos, pos+1)
+	if t.parents != nil {
+		return t.remeasure()
+	}
+
+	for j, w := range widths {
+		if w >= t.natWidths[j] {
+			t.measureColumn(j)
+		}
+	}
+
+	t.resetWidths()
+	return t
+}
+
+// SetCell updates the value of a single cell within the table. Only the row
+// and column containing the cell are measured again
+func (t *Table) SetCell(row, col int, value string) *Table {
+	rowOK := t.checkRow("set cell", row)
+	if !t.checkColumn("set cell", col) || !rowOK {
+		return t
+	}
+
+	t.ownData()
+	prevW, _ := t.measure(row, col)
+	if col >= len(t.data[row]) {
+		t.data[row] = append(t.data[row], make([]string, col-len(t.data[row])+1)...)
+	}
+	t.data[row][col] = value
+	delete(t.nested, cellPos{row: row, col: col})
+	delete(t.matches, cellPos{row: row, col: col})
+	delete(t.cleared, cellPos{row: row, col: col})
+
+	if t.parents != nil {
+		return t.remeasure()
+	}
+
+	t.rowHeights[row] = 0
+	t.measureRow(row)
+	if w, _ := t.measure(row, col); w < prevW && prevW >= t.natWidths[col] {
+		t.measureColumn(col)
+	}
+
+	t.resetWidths()
+	return t
+}
+
+// AppendColumn appends a column to the end of the table, with the first value
+// used as its key. Rows without a corresponding value are left empty. The new
+// column adopts the default configuration of the table
+func (t *Table) AppendColumn(values ...string) *Table {
+	if len(t.data) == 0 {
+		t.invalid("append column", "expected at least one row, got 0")
+		return t
+	}
+
+	t.ownData()
+	col := len(t.data[0])
+	for i, row := range t.data {
+		value := ""
+		if i < len(values) {
+			value = values[i]
+		}
+
+		// Pad any ragged row, so the value is placed beneath its key
+		if len(row) < col {
+			row = append(row, make([]string, col-len(row))...)
+		}
+		t.data[i] = slices.Insert(row, col, value)
+	}
+
+	if col == 0 {
+		return t.reset()
+	}
+
+	top, right, bottom, left := t.theme.CellStyle.GetPadding()
+	t.alignments = append(t.alignments, []lipgloss.Position{lipgloss.Left, lipgloss.Top})
+	t.directions = append(t.directions, LeftToRight)
+	t.paddings = append(t.paddings, padding{top: top, right: right, bottom: bottom, left: left})
+	t.priorities = append(t.priorities, 0)
+	t.order = append(slices.Clone(t.order), col)
+	t.visible = t.order
+	t.natWidths = append(t.natWidths, 0)
+	if t.parents != nil {
+		return t.remeasure()
+	}
+
+	t.measureColumn(col)
+	t.resetWidths()
+	return t
+}
+
+// ownData copies the data of the table before it is first mutated, ensuring
+// the data provided to [NewTable] is never modified
+func (t *Table) ownData() {
+	if t.owned {
+		return
+	}
+
+	data := make([][]string, len(t.data))
+	for i, row := range t.data {
+		data[i] = slices.Clone(row)
+	}
+	t.data = data
+	t.owned = true
+}
+
+func (t *Table) reset() *Table {
+	t.resetAlignments()
+	t.resetDirections()
+	t.resetPaddings()
+	t.resetColumns()
+	return t.remeasure()
+}
+
+func (t *Table) remeasure() *Table {
+	t.maxDimensions()
+	return t
+}
+
+func (t *Table) measureRow(row int) {
+	for j := range t.data[row][:min(len(t.data[row]), len(t.natWidths))] {
+		w, h := t.measure(row, j)
+		t.natWidths[j] = max(t.natWidths[j], w)
+		t.rowHeights[row] = max(t.rowHeights[row], h)
+	}
+}
+
+func (t *Table) measureColumn(col int) {
+	t.natWidths[col] = 0
+	for i, row := range t.data {
+		if col >= len(row) {
+			continue
+		}
+
+		w, h := t.measure(i, col)
+		t.natWidths[col] = max(t.natWidths[col], w)
+		t.rowHeights[i] = max(t.rowHeights[i], h)
+	}
+}
+
+// shiftRows shifts all row based configuration, such as nested blocks and
+// tree relationships, following the insertion or deletion of a row
+func (t *Table) shiftRows(from, delta int) {
+	shift := func(row int) int {
+		if row >= from {
+			return row + delta
+		}
+		return row
+	}
+
+	t.nested = shiftCells(t.nested, from, delta)
+	t.changes = shiftCells(t.changes, from, delta)
+	t.cleared = shiftCells(t.cleared, from, delta)
+	t.matches = shiftCells(t.matches, from, delta)
+
+	if t.folded != nil {
+		folded := make(map[int]bool, len(t.folded))
+		for row, on := range t.folded {
+			if delta < 0 && row == from {
+				continue
+			}
+			folded[shift(row)] = on
+		}
+		t.folded = folded
+	}
+
+	if t.parents != nil {
+		if delta > 0 {
+			t.parents = slices.Insert(t.parents, from, -1)
+		} else {
+			t.parents = slices.Delete(t.parents, from, from+1)
+		}
+
+		for i, p := range t.parents {
+			switch {
+			case delta < 0 && p == from:
+				t.parents[i] = -1
+			case p >= from:
+				t.parents[i] = p + delta
+			}
+		}
+	}
+}
+
+// shiftCells shifts the rows of all cell based configuration, following the
+// insertion or deletion of a row
+func shiftCells[V any](cells map[cellPos]V, from, delta int) map[cellPos]V {
+	if cells == nil {
+		return nil
+	}
+
+	shifted := make(map[cellPos]V, len(cells))
+	for pos, v := range cells {
+		switch {
+		case delta < 0 && pos.row == from:
+			continue
+		case pos.row >= from:
+			pos.row += delta
+		}
+		shifted[pos] = v
+	}
+	return shifted
+}
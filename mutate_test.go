@@ -0,0 +1,137 @@
+package theme_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charmbracelet/x/exp/golden"
+	theme "github.com/purpleclay/lipgloss-theme"
+)
+
+func clone(data [][]string) [][]string {
+	cloned := make([][]string, 0, len(data))
+	for _, row := range data {
+		cloned = append(cloned, slices.Clone(row))
+	}
+	return cloned
+}
+
+func TestTableMutations(t *testing.T) {
+	penguin := []string{"Penguin", "Male", "Short stature, monocle, top hat and umbrella", "6"}
+
+	tests := []struct {
+		name     string
+		mutate   func(*theme.Table) *theme.Table
+		expected [][]string
+	}{
+		{
+			name: "AppendRow",
+			mutate: func(tbl *theme.Table) *theme.Table {
+				return tbl.AppendRow(penguin)
+			},
+			expected: append(clone(data), penguin),
+		},
+		{
+			name: "InsertRow",
+			mutate: func(tbl *theme.Table) *theme.Table {
+				return tbl.InsertRow(1, penguin)
+			},
+			expected: slices.Insert(clone(data), 1, penguin),
+		},
+		{
+			name: "DeleteRow",
+			mutate: func(tbl *theme.Table) *theme.Table {
+				// The Joker determines the width of the distinguishing features column
+				return tbl.DeleteRow(1).DeleteRow(1)
+			},
+			expected: slices.Delete(clone(data), 1, 3),
+		},
+		{
+			name: "SetCellGrow",
+			mutate: func(tbl *theme.Table) *theme.Table {
+				return tbl.SetCell(6, 0, "Edward Nygma, The Riddler")
+			},
+			expected: func() [][]string {
+				d := clone(data)
+				d[6][0] = "Edward Nygma, The Riddler"
+				return d
+			}(),
+		},
+		{
+			name: "SetCellShrink",
+			mutate: func(tbl *theme.Table) *theme.Table {
+				return tbl.SetCell(2, 2, "Mallet").SetCell(1, 2, "Clown")
+			},
+			expected: func() [][]string {
+				d := clone(data)
+				d[2][2] = "Mallet"
+				d[1][2] = "Clown"
+				return d
+			}(),
+		},
+		{
+			name: "AppendColumn",
+			mutate: func(tbl *theme.Table) *theme.Table {
+				return tbl.AppendColumn("First Appearance", "1940", "1992", "1942", "1941", "1948")
+			},
+			expected: func() [][]string {
+				d := clone(data)
+				for i, v := range []string{"First Appearance", "1940", "1992", "1942", "1941", "1948", ""} {
+					d[i] = append(d[i], v)
+				}
+				return d
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := clone(data)
+			tbl := tt.mutate(theme.NewTable(data).Border(theme.ThinBorder))
+
+			expected := theme.NewTable(tt.expected).Border(theme.ThinBorder).String()
+			if got := tbl.String(); got != expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+			}
+
+			if !slices.EqualFunc(data, original, slices.Equal) {
+				t.Error("expected the original table data to be left untouched")
+			}
+		})
+	}
+}
+
+func TestTableMutationsTree(t *testing.T) {
+	t.Parallel()
+	tbl := theme.NewTable(treeData).
+		Border(theme.ThinBorder).
+		Dividers(false).
+		Parent(2, 1).
+		Parent(3, 1).
+		Parent(4, 3).
+		Parent(5, 3).
+		Parent(7, 6).
+		InsertRow(3, []string{"go.mod", "1 KB"}).
+		Parent(3, 1).
+		DeleteRow(6)
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
+func TestTableAppendColumnRaggedRow(t *testing.T) {
+	t.Parallel()
+	tbl := theme.NewTable([][]string{
+		{"Name", "Alias", "Status"},
+		{"Bruce Wayne"},
+		{"Dick Grayson", "Nightwing", "Active"},
+	}).AppendColumn("City", "Gotham", "Blüdhaven")
+
+	expected := theme.NewTable([][]string{
+		{"Name", "Alias", "Status", "City"},
+		{"Bruce Wayne", "", "", "Gotham"},
+		{"Dick Grayson", "Nightwing", "Active", "Blüdhaven"},
+	}).String()
+
+	if got := tbl.String(); got != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,86 @@
+package theme
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Resizer is implemented by any block of content that can be rendered to fit
+// within a given width. A nested block that implements it will be resized by
+// the width constraints of the table that contains it
+type Resizer interface {
+	RenderWidth(w int) string
+}
+
+type cellPos struct {
+	row int
+	col int
+}
+
+// Nest embeds a block of content, such as another [Table], within a cell of the
+// table, replacing its existing content. If the block implements [Resizer], it
+// will be rendered to fit the width of its column, otherwise it is rendered as is.
+// A nested table will resolve its column widths against the width of its cell,
+// shrinking any columns sized around their content to fit
+//
+//	inner := theme.NewTable(data)
+//	theme.NewTable(outer).TotalWidth(80).Nest(1, 1, inner)
+func (t *Table) Nest(row, col int, block fmt.Stringer) *Table {
+	// Both the row and column are checked, so each problem is reported
+	rowOK := t.checkRow("nest", row)
+	if !t.checkColumn("nest", col) || !rowOK {
+		return t
+	}
+
+	if t.nested == nil {
+		t.nested = map[cellPos]fmt.Stringer{}
+	}
+	t.nested[cellPos{row: row, col: col}] = block
+
+	t.maxDimensions()
+	return t
+}
+
+// RenderWidth renders the table to fit within the given width, resolving its
+// column widths against it in the same way as [Table.TotalWidth]. The table
+// itself is left untouched
+func (t *Table) RenderWidth(w int) string {
+	c := *t
+	c.colWidths = slices.Clone(t.colWidths)
+	c.errs = slices.Clone(t.errs)
+	return c.TotalWidth(w).String()
+}
+
+// content returns the content of a cell, decorated with any styling applied
+// when rendered. Any nested block is rendered to fit the given width, if supported
+func (t *Table) content(row, col, w int) string {
+	block, ok := t.nested[cellPos{row: row, col: col}]
+	if !ok {
+		if col >= len(t.data[row]) {
+			return ""
+		}
+		return t.decorate(row, col, t.data[row][col])
+	}
+
+	if r, ok := block.(Resizer); ok && w > 0 {
+		return r.RenderWidth(w)
+	}
+	return block.String()
+}
+
+// decorate highlights any search matches within the content of a cell, before
+// applying any styling from a diff. Styling is resolved from the theme of the
+// table, leaving its data untouched
+func (t *Table) decorate(row, col int, str string) string {
+	if m, ok := t.matches[cellPos{row: row, col: col}]; ok {
+		str = highlight(t.theme.Mark, str, m)
+	}
+
+	if s, ok := t.changeStyle(row, col); ok {
+		if old, ok := t.cleared[cellPos{row: row, col: col}]; ok {
+			str = old
+		}
+		return s.Render(str)
+	}
+	return str
+}
@@ -0,0 +1,137 @@
+package theme
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Records controls whether the table should be rendered as a series of records,
+// much like the expanded display of psql. Each row becomes a block of key and
+// value pairs, using the first row of the table as its keys. The same border is
+// used, with a divider rendered between each record. Wide rows that would be
+// unreadable within a narrow terminal become easy to scan. Row numbers are
+// rendered as the first pair of each record, counting from the first row of
+// data. As every column is rendered within each record, column priorities
+// have no effect and are reported by [Table.Validate]
+//
+//	┌──────────┬───────────┐
+//	│ Name     │ The Joker │
+//	│ Sex      │ Male      │
+//	├──────────┼───────────┤
+//	│ Name     │ Riddler   │
+//	│ Sex      │ Male      │
+//	└──────────┴───────────┘
+func (t *Table) Records(on bool) *Table {
+	t.records = on
+	return t
+}
+
+func (t *Table) recordStyle() lipgloss.Style {
+	if t.collapsed {
+		return t.theme.CellStyle.UnsetPadding()
+	}
+	return t.theme.CellStyle
+}
+
+// recordWidths calculates the width of both the key and value columns. Values
+// will fill the total width of the table if set, otherwise they are sized
+// around their content
+func (t *Table) recordWidths() (int, int) {
+	keyW := 0
+	for _, col := range t.order {
+		keyW = max(keyW, lipgloss.Width(t.recordStyle().Render(t.data[0][col])))
+	}
+
+	if t.index != nil {
+		keyW = max(keyW, lipgloss.Width(t.recordStyle().Render(t.index.header)))
+	}
+
+	if t.totalWidth > 0 {
+		vertW := lipgloss.Width(t.tableBorder().Vertical)
+		return keyW, max(t.totalWidth-keyW-vertW*3, 1)
+	}
+
+	valW := 0
+	for i := 1; i < len(t.data); i++ {
+		if t.index != nil {
+			valW = max(valW, lipgloss.Width(t.recordStyle().Render(t.recordNumber(i))))
+		}
+
+		for _, col := range t.order {
+			valW = max(valW, lipgloss.Width(t.recordStyle().Render(t.content(i, col, 0))))
+		}
+	}
+	return keyW, valW
+}
+
+// recordNumber returns the row number of a record. As the header is never
+// rendered as a record, numbering always starts from the first row of data
+func (t *Table) recordNumber(row int) string {
+	return strconv.Itoa(t.index.start + row - 1)
+}
+
+func (t *Table) renderRecords() string {
+	border := t.tableBorder()
+	keyW, valW := t.recordWidths()
+	keyStyle := t.recordStyle().
+		Width(keyW).
+		Foreground(t.theme.Logging.Key.GetForeground())
+
+	// Without any data, only the keys of the table are rendered
+	if len(t.data) == 1 {
+		var keys []string
+		for _, col := range t.order {
+			key := keyStyle.Render(t.data[0][col])
+			vertJoin := t.verticalDivider(border.Vertical, lipgloss.Height(key))
+			keys = append(keys, lipgloss.JoinHorizontal(lipgloss.Left, vertJoin, key, vertJoin))
+		}
+
+		return lipgloss.JoinVertical(
+			lipgloss.Top,
+			t.divider(border.TopLeft, border.Top, border.TopJoin, border.TopRight, keyW),
+			strings.Join(keys, "\n"),
+			t.divider(border.BottomLeft, border.Bottom, border.BottomJoin, border.BottomRight, keyW),
+		)
+	}
+
+	pair := func(key, val string) string {
+		h := lipgloss.Height(val)
+		vertJoin := t.verticalDivider(border.Vertical, h)
+		key = lipgloss.PlaceVertical(h, lipgloss.Top, keyStyle.Render(key))
+		return lipgloss.JoinHorizontal(lipgloss.Left, vertJoin, key, vertJoin, val, vertJoin)
+	}
+
+	var records []string
+	for i := 1; i < len(t.data); i++ {
+		var pairs []string
+		if t.index != nil {
+			num := t.recordStyle().Faint(true).Width(valW).Render(t.recordNumber(i))
+			pairs = append(pairs, pair(t.index.header, num))
+		}
+
+		for _, col := range t.order {
+			hAlign := t.alignments[col][0]
+			if t.directions[col] == RightToLeft {
+				hAlign = 1 - hAlign
+			}
+
+			val := t.recordStyle().
+				Width(valW).
+				MaxWidth(valW).
+				AlignHorizontal(hAlign).
+				Render(t.content(i, col, valW-t.recordStyle().GetHorizontalPadding()))
+
+			pairs = append(pairs, pair(t.data[0][col], val))
+		}
+		records = append(records, strings.Join(pairs, "\n"))
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Top,
+		t.divider(border.TopLeft, border.Top, border.TopJoin, border.TopRight, keyW, valW),
+		strings.Join(records, "\n"+t.divider(border.MiddleLeft, border.Middle, border.MiddleJoin, border.MiddleRight, keyW, valW)+"\n"),
+		t.divider(border.BottomLeft, border.Bottom, border.BottomJoin, border.BottomRight, keyW, valW),
+	)
+}
@@ -0,0 +1,96 @@
+package theme
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// lightness defines the perceptual lightness (CIELAB) targeted by each step of
+// a generated scale, from the lightest (50) to the darkest (950)
+var lightness = [11]float64{0.97, 0.93, 0.86, 0.76, 0.64, 0.53, 0.44, 0.36, 0.28, 0.20, 0.12}
+
+// GenerateScale generates an 11 step scale of shades from a base hex color, ordered
+// from the lightest (50) to the darkest (950). Shades are spread evenly within the
+// CIELCh color space, retaining the hue of the base color. The base color is kept
+// as is, replacing the step closest to its own lightness
+//
+//	scale, err := theme.GenerateScale("#0f766e")
+func GenerateScale(base lipgloss.Color) ([]lipgloss.Color, error) {
+	c, err := colorful.Hex(string(base))
+	if err != nil {
+		return nil, fmt.Errorf("scale: expected a hex color, got %q", string(base))
+	}
+
+	h, chroma, l := c.Hcl()
+
+	k := 0
+	for i, t := range lightness {
+		if math.Abs(t-l) < math.Abs(lightness[k]-l) {
+			k = i
+		}
+	}
+
+	first, last := lightness[0], lightness[len(lightness)-1]
+	scale := make([]lipgloss.Color, len(lightness))
+	for i, t := range lightness {
+		if i == k {
+			scale[i] = lipgloss.Color(c.Hex())
+			continue
+		}
+
+		// Stretch the targeted lightness of each step either side of the base
+		// color, so the scale remains in order from lightest to darkest
+		var li float64
+		if i < k {
+			li = first + (t-first)*(l-first)/(lightness[k]-first)
+		} else {
+			li = last + (t-last)*(l-last)/(lightness[k]-last)
+		}
+
+		// Shades further from the base color are less saturated
+		ci := chroma * (1 - 0.6*math.Abs(li-l))
+		scale[i] = lipgloss.Color(inGamut(h, ci, li).Hex())
+	}
+	return scale, nil
+}
+
+// inGamut reduces the chroma of a CIELCh color until it can be represented
+// in RGB
+func inGamut(h, c, l float64) colorful.Color {
+	col := colorful.Hcl(h, c, l)
+	for !col.IsValid() && c > 0.001 {
+		c *= 0.95
+		col = colorful.Hcl(h, c, l)
+	}
+	return col.Clamped()
+}
+
+// GeneratePalette generates a palette from a base hex color, replacing the PurpleClay
+// shades with a scale generated by [GenerateScale]. The accent colors of [PurpleClay]
+// are retained. A full theme can then be created from it
+//
+//	p, err := theme.GeneratePalette("#0f766e")
+//	th := theme.NewTheme(p)
+func GeneratePalette(base lipgloss.Color) (Palette, error) {
+	scale, err := GenerateScale(base)
+	if err != nil {
+		return Palette{}, err
+	}
+
+	p := PurpleClay
+	p.S50 = scale[0]
+	p.S100 = scale[1]
+	p.S200 = scale[2]
+	p.S300 = scale[3]
+	p.S400 = scale[4]
+	p.S500 = scale[5]
+	p.S600 = scale[6]
+	p.S700 = scale[7]
+	p.S800 = scale[8]
+	p.S900 = scale[9]
+	p.S950 = scale[10]
+	return p, nil
+}
@@ -0,0 +1,72 @@
+package theme_test
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	theme "github.com/purpleclay/lipgloss-theme"
+)
+
+func TestGenerateScale(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		base lipgloss.Color
+	}{
+		{name: "Teal", base: "#0f766e"},
+		{name: "Light", base: "#fde68a"},
+		{name: "Dark", base: "#1e1b4b"},
+		{name: "Grey", base: "#808080"},
+		{name: "White", base: "#ffffff"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scale, err := theme.GenerateScale(tt.base)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(scale) != 11 {
+				t.Fatalf("expected 11 shades, got %d", len(scale))
+			}
+
+			var found bool
+			for i, c := range scale {
+				found = found || c == tt.base
+				if i > 0 && luminance(c) >= luminance(scale[i-1]) {
+					t.Errorf("expected %s to be darker than %s in %v", c, scale[i-1], scale)
+				}
+			}
+
+			if !found {
+				t.Errorf("expected base color %s within %v", tt.base, scale)
+			}
+		})
+	}
+}
+
+func TestGenerateScaleInvalidColor(t *testing.T) {
+	t.Parallel()
+	_, err := theme.GenerateScale("212")
+	if err == nil || err.Error() != `scale: expected a hex color, got "212"` {
+		t.Errorf("expected an invalid color error, got %v", err)
+	}
+}
+
+func TestGeneratePalette(t *testing.T) {
+	t.Parallel()
+	p, err := theme.GeneratePalette("#0f766e")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if p.Green900 != theme.Green900 || p.Red700 != theme.Red700 || p.Blue900 != theme.Blue900 {
+		t.Errorf("expected the PurpleClay accent colors to be retained")
+	}
+
+	th := theme.NewTheme(p)
+	if th.Tokens.Primary.Light != string(p.S400) {
+		t.Errorf("expected the theme to be derived from the generated palette")
+	}
+}
@@ -1,8 +1,9 @@
 package theme
 
 import (
+	"sync"
+
 	"github.com/charmbracelet/lipgloss"
-	"github.com/charmbracelet/log"
 )
 
 // Defines the PurpleClay palette of color shades
@@ -20,169 +21,155 @@ var (
 	S50  = lipgloss.Color("#a980db")
 )
 
-// Defines the PurpleClay palette for accent color shades
+// Defines the PurpleClay palette of green accent color shades
 var (
+	Green950 = lipgloss.Color("#033d19")
 	Green900 = lipgloss.Color("#087331")
+	Green800 = lipgloss.Color("#0e7a37")
 	Green700 = lipgloss.Color("#15803d")
+	Green600 = lipgloss.Color("#0f9347")
+	Green500 = lipgloss.Color("#16b058")
+	Green400 = lipgloss.Color("#34d178")
+	Green300 = lipgloss.Color("#6de8a0")
+	Green200 = lipgloss.Color("#a6f4c3")
+	Green100 = lipgloss.Color("#d1fae0")
+	Green50  = lipgloss.Color("#ecfdf3")
+)
+
+// Defines the PurpleClay palette of amber accent color shades
+var (
+	Amber950 = lipgloss.Color("#662002")
 	Amber900 = lipgloss.Color("#bf4102")
+	Amber800 = lipgloss.Color("#cd4602")
 	Amber700 = lipgloss.Color("#db4b02")
-	Red900   = lipgloss.Color("#ab0513")
-	Red700   = lipgloss.Color("#db0f20")
+	Amber600 = lipgloss.Color("#e85a04")
+	Amber500 = lipgloss.Color("#f56a0b")
+	Amber400 = lipgloss.Color("#fb9438")
+	Amber300 = lipgloss.Color("#fdbc71")
+	Amber200 = lipgloss.Color("#fed9a8")
+	Amber100 = lipgloss.Color("#ffeed4")
+	Amber50  = lipgloss.Color("#fff8ed")
 )
 
+// Defines the PurpleClay palette of red accent color shades
 var (
-	// A defines a PurpleClay themed hyperlink that supports both light and dark terminals
-	A = lipgloss.NewStyle().
-		Bold(true).
-		Underline(true).
-		Foreground(lipgloss.AdaptiveColor{
-			Light: string(S400),
-			Dark:  string(S100),
-		})
+	Red950 = lipgloss.Color("#5c0208")
+	Red900 = lipgloss.Color("#ab0513")
+	Red800 = lipgloss.Color("#c30a1a")
+	Red700 = lipgloss.Color("#db0f20")
+	Red600 = lipgloss.Color("#e81a2b")
+	Red500 = lipgloss.Color("#f43542")
+	Red400 = lipgloss.Color("#fc6671")
+	Red300 = lipgloss.Color("#ff9ea5")
+	Red200 = lipgloss.Color("#ffc6ca")
+	Red100 = lipgloss.Color("#ffe0e2")
+	Red50  = lipgloss.Color("#fff1f2")
+)
 
-	h = lipgloss.NewStyle().Padding(0, 1).Bold(true).Foreground(lipgloss.Color("#ffffff"))
+// Defines the PurpleClay palette of blue accent color shades
+var (
+	Blue950 = lipgloss.Color("#172b54")
+	Blue900 = lipgloss.Color("#1e458a")
+	Blue800 = lipgloss.Color("#1e50af")
+	Blue700 = lipgloss.Color("#1d63d8")
+	Blue600 = lipgloss.Color("#2577eb")
+	Blue500 = lipgloss.Color("#3b95f6")
+	Blue400 = lipgloss.Color("#60b4fa")
+	Blue300 = lipgloss.Color("#93cffd")
+	Blue200 = lipgloss.Color("#bfe2fe")
+	Blue100 = lipgloss.Color("#dbeefe")
+	Blue50  = lipgloss.Color("#eff8ff")
+)
+
+// def is the theme that all package level styles are aliases of
+var def = Default()
+
+var (
+	// A defines a PurpleClay themed hyperlink that supports both light and dark terminals
+	A = def.A
 
 	// H1 defines a PurpleClay themed header that is ranked on importance from
 	// H1 (most) to H6 (least). Supports both light and dark terminals
-	H1 = h.
-		Background(lipgloss.AdaptiveColor{
-			Light: string(S50),
-			Dark:  string(S200),
-		})
+	H1 = def.H1
 
 	// H2 defines a PurpleClay themed header that is ranked on importance from
 	// H1 (most) to H6 (least). Supports both light and dark terminals
-	H2 = h.
-		Background(lipgloss.AdaptiveColor{
-			Light: string(S100),
-			Dark:  string(S300),
-		})
+	H2 = def.H2
 
 	// H3 defines a PurpleClay themed header that is ranked on importance from
 	// H1 (most) to H6 (least). Supports both light and dark terminals
-	H3 = h.
-		Background(lipgloss.AdaptiveColor{
-			Light: string(S200),
-			Dark:  string(S400),
-		})
+	H3 = def.H3
 
 	// H4 defines a PurpleClay themed header that is ranked on importance from
 	// H1 (most) to H6 (least). Supports both light and dark terminals
-	H4 = h.
-		Background(lipgloss.AdaptiveColor{
-			Light: string(S300),
-			Dark:  string(S500),
-		})
+	H4 = def.H4
 
 	// H5 defines a PurpleClay themed header that is ranked on importance from
 	// H1 (most) to H6 (least). Supports both light and dark terminals
-	H5 = h.
-		Background(lipgloss.AdaptiveColor{
-			Light: string(S400),
-			Dark:  string(S600),
-		})
+	H5 = def.H5
 
 	// H6 defines a PurpleClay themed header that is ranked on importance from
 	// H1 (most) to H6 (least). Supports both light and dark terminals
-	H6 = h.
-		Background(lipgloss.AdaptiveColor{
-			Light: string(S500),
-			Dark:  string(S700),
-		})
+	H6 = def.H6
 
 	// Mark defines a PurpleClay themed text decoration for highlighting text. Supports
 	// both light and dark terminals
-	Mark = lipgloss.NewStyle().
-		Padding(0, 1).
-		Background(lipgloss.AdaptiveColor{
-			Light: string(S50),
-			Dark:  string(S700),
-		})
+	Mark = def.Mark
 
 	// I defines a PurpleClay themed hyperlink that supports both light and dark terminals
-	I = lipgloss.NewStyle().Italic(true)
+	I = def.I
 
 	// U defines a PurpleClay themed underline text decoration
-	U = lipgloss.NewStyle().Underline(true)
+	U = def.U
 
 	// B defines a PurpleClay themed bold text decoration
-	B = lipgloss.NewStyle().Bold(true)
+	B = def.B
 
 	// S defines a PurpleClay themed strikethrough text decoration
-	S = lipgloss.NewStyle().Strikethrough(true)
-
-	// Tick defines a PurpleClay themed glyph ✓ that supports both light and dark terminals
-	Tick = lipgloss.NewStyle().
-		Foreground(lipgloss.AdaptiveColor{
-			Light: string(Green900),
-			Dark:  string(Green700),
-		}).
-		Render("✓")
-
-	// Cross defines a PurpleClay themed glyph x that supports both light and dark terminals
-	Cross = lipgloss.NewStyle().
-		Foreground(lipgloss.AdaptiveColor{
-			Light: string(Red900),
-			Dark:  string(Red700),
-		}).
-		Render("✕")
+	S = def.S
+
+	// Tick defines a PurpleClay themed glyph ✓ that supports both light and dark terminals.
+	// Downgrades to v if the terminal does not support unicode
+	Tick = def.Tick
+
+	// Cross defines a PurpleClay themed glyph ✕ that supports both light and dark terminals.
+	// Downgrades to x if the terminal does not support unicode
+	Cross = def.Cross
 
 	// Bang defines a PurpleClay themed glyph ! that supports both light and dark terminals
-	Bang = lipgloss.NewStyle().
-		Foreground(lipgloss.AdaptiveColor{
-			Light: string(Amber900),
-			Dark:  string(Amber700),
-		}).
-		Render("!")
+	Bang = def.Bang
 
 	// Logging defines a PurpleClay themed [logging] style that supports both light and
 	// dark terminals
 	//
 	// [logging]: https://github.com/charmbracelet/log
-	Logging = &log.Styles{
-		Timestamp: lipgloss.NewStyle(),
-		Caller:    lipgloss.NewStyle().Faint(true),
-		Prefix:    lipgloss.NewStyle().Bold(true).Faint(true),
-		Message:   lipgloss.NewStyle().MarginRight(2),
-		Key: lipgloss.NewStyle().
-			Foreground(lipgloss.AdaptiveColor{
-				Light: string(S400),
-				Dark:  string(S100),
-			}),
-		Value:     lipgloss.NewStyle(),
-		Separator: lipgloss.NewStyle().Faint(true),
-		Levels: map[log.Level]lipgloss.Style{
-			log.DebugLevel: lipgloss.NewStyle().
-				SetString(Tick).
-				Bold(true).
-				MaxWidth(2),
-			log.InfoLevel: lipgloss.NewStyle().
-				SetString(Tick).
-				Bold(true).
-				MaxWidth(2),
-			log.WarnLevel: lipgloss.NewStyle().
-				SetString(Bang).
-				Bold(true).
-				MaxWidth(2),
-			log.ErrorLevel: lipgloss.NewStyle().
-				SetString(Cross).
-				Bold(true).
-				MaxWidth(2),
-			log.FatalLevel: lipgloss.NewStyle().
-				SetString(Cross).
-				Bold(true).
-				MaxWidth(2),
-		},
-		Keys: map[string]lipgloss.Style{
-			"err": lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{
-				Light: string(Red900),
-				Dark:  string(Red700),
-			}),
-			"error": lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{
-				Light: string(Red900),
-				Dark:  string(Red700),
-			}),
-		},
-		Values: map[string]lipgloss.Style{},
-	}
+	Logging = def.Logging
 )
+
+// setDefault replaces the theme that all package level styles are aliases of
+func setDefault(th *Theme) {
+	def = th
+	A, H1, H2, H3, H4, H5, H6 = th.A, th.H1, th.H2, th.H3, th.H4, th.H5, th.H6
+	Mark, I, U, B, S = th.Mark, th.I, th.U, th.B, th.S
+	Tick, Cross, Bang = th.Tick, th.Cross, th.Bang
+	Logging = th.Logging
+
+	tk := th.Tokens
+	Primary, Secondary, Surface, Muted = tk.Primary, tk.Secondary, tk.Surface, tk.Muted
+	Success, Warning, Danger, Info = tk.Success, tk.Warning, tk.Danger, tk.Info
+	Border, Link = tk.Border, tk.Link
+}
+
+// glyphsMu guards the glyphs of the default theme, as unicode support can be
+// changed at any time
+var glyphsMu sync.Mutex
+
+func resetGlyphs() {
+	glyphsMu.Lock()
+	defer glyphsMu.Unlock()
+
+	def.resetGlyphs()
+	Tick = def.Tick
+	Cross = def.Cross
+	Bang = def.Bang
+}
@@ -1,6 +1,9 @@
 package theme
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -25,28 +28,50 @@ type TableBorder struct {
 	Vertical    string
 }
 
-var (
-	bdr = lipgloss.NewStyle().
-		Foreground(lipgloss.AdaptiveColor{
-			Light: string(S400),
-			Dark:  string(S200),
-		})
-
-	cell = lipgloss.NewStyle().Padding(0, 1)
-)
+type padding struct {
+	top    int
+	right  int
+	bottom int
+	left   int
+}
 
 // Table supports the rendering of tabular data within a terminal
 type Table struct {
 	border     TableBorder
 	rowHeights []int
 	colWidths  []int
+	natWidths  []int
+	widthSpecs []ColumnWidth
+	totalWidth int
 	data       [][]string
-	top        string
-	middle     string
-	bottom     string
 	dividers   bool
 	collapsed  bool
+	paddings   []padding
+	spacing    int
 	alignments [][]lipgloss.Position
+	directions []Direction
+	isolated   bool
+	index      *rowIndex
+	order      []int
+	visible    []int
+	priorities []int
+	indicator  bool
+	records    bool
+	nested     map[cellPos]fmt.Stringer
+	changes    map[cellPos]change
+	cleared    map[cellPos]string
+	matches    map[cellPos][][]int
+	parents    []int
+	folded     map[int]bool
+	markers    bool
+	errs       []optionErr
+	theme      *Theme
+	owned      bool
+}
+
+type rowIndex struct {
+	start  int
+	header string
 }
 
 // NewTable creates a table that will dynamically size around its provided
@@ -66,11 +91,14 @@ func NewTable(data [][]string) *Table {
 		data:       data,
 		dividers:   true,
 		collapsed:  false,
+		theme:      def,
 	}
 
 	t.resetAlignments()
+	t.resetDirections()
+	t.resetPaddings()
+	t.resetColumns()
 	t.maxDimensions()
-	t.resetDividers()
 	return t
 }
 
@@ -87,55 +115,269 @@ func (t *Table) resetAlignments() {
 	}
 }
 
-func (t *Table) maxDimensions() {
+func (t *Table) resetDirections() {
 	if len(t.data) == 0 || len(t.data[0]) == 0 {
 		return
 	}
 
-	cellStyle := cell
+	t.directions = make([]Direction, len(t.data[0]))
+}
+
+func (t *Table) resetPaddings() {
+	if len(t.data) == 0 || len(t.data[0]) == 0 {
+		return
+	}
+
+	top, right, bottom, left := t.theme.CellStyle.GetPadding()
+	t.paddings = make([]padding, len(t.data[0]))
+	for i := range t.paddings {
+		t.paddings[i] = padding{top: top, right: right, bottom: bottom, left: left}
+	}
+}
+
+func (t *Table) resetColumns() {
+	if len(t.data) == 0 || len(t.data[0]) == 0 {
+		return
+	}
+
+	t.order = make([]int, len(t.data[0]))
+	for i := range t.order {
+		t.order[i] = i
+	}
+	t.visible = t.order
+	t.priorities = make([]int, len(t.data[0]))
+}
+
+// cellStyle returns the style for rendering all cells within a given column,
+// removing any padding if the table has been collapsed
+func (t *Table) cellStyle(col int) lipgloss.Style {
 	if t.collapsed {
-		cellStyle = cellStyle.UnsetPadding()
+		return t.theme.CellStyle.UnsetPadding()
+	}
+
+	p := t.paddings[col]
+	return t.theme.CellStyle.Padding(p.top, p.right, p.bottom, p.left)
+}
+
+func (t *Table) maxDimensions() {
+	if len(t.data) == 0 || len(t.data[0]) == 0 {
+		return
 	}
 
 	t.rowHeights = make([]int, len(t.data))
-	t.colWidths = make([]int, len(t.data[0]))
+	t.natWidths = make([]int, len(t.data[0]))
+
+	guides := make([]int, len(t.data))
+	for _, r := range t.treeRows() {
+		guides[r.row] = lipgloss.Width(r.guide)
+	}
 
 	for i, row := range t.data {
-		for j, c := range row {
-			w, h := lipgloss.Size(cellStyle.Render(c))
-			t.colWidths[j] = max(t.colWidths[j], w)
+		for j := range row[:min(len(row), len(t.natWidths))] {
+			w, h := t.measure(i, j)
+			if j == t.treeCol() {
+				w += guides[i]
+			}
+			t.natWidths[j] = max(t.natWidths[j], w)
 			t.rowHeights[i] = max(t.rowHeights[i], h)
 		}
 	}
+	t.resetWidths()
 }
 
-func (t *Table) resetDividers() {
-	t.top = divider(
-		t.border.TopLeft,
-		t.border.Top,
-		t.border.TopJoin,
-		t.border.TopRight,
-		t.colWidths...,
-	)
+// measure returns the natural size of a cell within the table
+func (t *Table) measure(row, col int) (int, int) {
+	return lipgloss.Size(t.cellStyle(col).Render(t.content(row, col, 0)))
+}
 
-	t.middle = divider(
-		t.border.MiddleLeft,
-		t.border.Middle,
-		t.border.MiddleJoin,
-		t.border.MiddleRight,
-		t.colWidths...,
-	)
+// resetWidths resets the width of each column to its natural width, before
+// resolving it against any configured column widths
+func (t *Table) resetWidths() {
+	t.colWidths = slices.Clone(t.natWidths)
+	if len(t.widthSpecs) > 0 || t.totalWidth > 0 {
+		t.resolveWidths()
+	}
+}
 
-	t.bottom = divider(
-		t.border.BottomLeft,
-		t.border.Bottom,
-		t.border.BottomJoin,
-		t.border.BottomRight,
-		t.colWidths...,
-	)
+// resolveWidths resolves the width of all visible columns against the total width
+// of the table. If the table cannot fit within its total width, the columns with
+// the lowest priority are hidden until it does, before any columns sized around
+// their content are shrunk
+func (t *Table) resolveWidths() {
+	specs := expandWidths(t.widthSpecs, len(t.natWidths))
+
+	t.visible = t.order
+	for {
+		t.resolveVisibleWidths(specs)
+		if !t.overflows() {
+			break
+		}
+		t.visible = t.dropLowestPriority()
+	}
+	t.shrinkAutoWidths(specs)
+}
+
+func (t *Table) resolveVisibleWidths(specs []ColumnWidth) {
+	available := t.totalWidth
+	if available > 0 {
+		cols := len(t.visible)
+		if t.index != nil {
+			available -= t.indexWidth()
+			cols++
+		}
+
+		if t.indicator && t.hidden() {
+			available -= lipgloss.Width(t.indicatorCell())
+			cols++
+		}
+		available = max(available-lipgloss.Width(t.tableBorder().Vertical)*(cols+1), 1)
+	}
+
+	visSpecs := make([]ColumnWidth, 0, len(t.visible))
+	visWidths := make([]int, 0, len(t.visible))
+	for _, col := range t.visible {
+		visSpecs = append(visSpecs, specs[col])
+		visWidths = append(visWidths, t.natWidths[col])
+	}
+
+	for i, w := range resolveWidths(visSpecs, visWidths, available) {
+		t.colWidths[t.visible[i]] = w
+	}
+}
+
+func (t *Table) overflows() bool {
+	if t.totalWidth <= 0 || len(t.visible) <= 1 {
+		return false
+	}
+
+	// Only hide columns when priorities have explicitly been set
+	if !slices.ContainsFunc(t.priorities, func(p int) bool { return p != 0 }) {
+		return false
+	}
+
+	return t.renderedWidth() > t.totalWidth
+}
+
+// renderedWidth returns the width of the table when rendered, including any borders
+func (t *Table) renderedWidth() int {
+	widths := t.widths()
+	w := lipgloss.Width(t.tableBorder().Vertical) * (len(widths) + 1)
+	for _, cw := range widths {
+		w += cw
+	}
+	return w
 }
 
-func divider(left, sep, join, right string, cellW ...int) string {
+// shrinkAutoWidths shrinks any columns sized around their content, in proportion
+// to the width each can give up, if the table would otherwise overflow its total
+// width. A column is never shrunk below its minimum width, or its horizontal
+// padding plus a single cell of content. Any remaining overflow is reported
+// by [Table.Validate]
+func (t *Table) shrinkAutoWidths(specs []ColumnWidth) {
+	if t.totalWidth <= 0 {
+		return
+	}
+
+	excess := t.renderedWidth() - t.totalWidth
+	if excess <= 0 {
+		return
+	}
+
+	cols := make([]int, 0, len(t.visible))
+	slack := map[int]int{}
+	total := 0
+	for _, col := range t.visible {
+		if specs[col].kind != autoWidth {
+			continue
+		}
+
+		floor := max(specs[col].min, t.cellStyle(col).GetHorizontalPadding()+1)
+		if t.colWidths[col] > floor {
+			cols = append(cols, col)
+			slack[col] = t.colWidths[col] - floor
+			total += slack[col]
+		}
+	}
+
+	if total == 0 {
+		return
+	}
+
+	excess = min(excess, total)
+	shrunk := 0
+	for _, col := range cols {
+		cut := excess * slack[col] / total
+		t.colWidths[col] -= cut
+		slack[col] -= cut
+		shrunk += cut
+	}
+
+	// Any width lost through integer division is taken from each column in turn
+	for i := 0; shrunk < excess; i = (i + 1) % len(cols) {
+		if col := cols[i]; slack[col] > 0 {
+			t.colWidths[col]--
+			slack[col]--
+			shrunk++
+		}
+	}
+}
+
+// dropLowestPriority returns all visible columns, minus the column with the lowest
+// priority. If multiple columns share the lowest priority, the rightmost is dropped
+func (t *Table) dropLowestPriority() []int {
+	drop := len(t.visible) - 1
+	for i := len(t.visible) - 1; i >= 0; i-- {
+		if t.priorities[t.visible[i]] < t.priorities[t.visible[drop]] {
+			drop = i
+		}
+	}
+
+	visible := make([]int, 0, len(t.visible)-1)
+	visible = append(visible, t.visible[:drop]...)
+	return append(visible, t.visible[drop+1:]...)
+}
+
+func (t *Table) hidden() bool {
+	return len(t.visible) < len(t.order)
+}
+
+// rowDividers renders the top, middle and bottom dividers of the table from
+// the given border and column widths
+func (t *Table) rowDividers(border TableBorder, widths []int) (string, string, string) {
+	top := t.divider(border.TopLeft, border.Top, border.TopJoin, border.TopRight, widths...)
+	middle := t.divider(border.MiddleLeft, border.Middle, border.MiddleJoin, border.MiddleRight, widths...)
+	bottom := t.divider(border.BottomLeft, border.Bottom, border.BottomJoin, border.BottomRight, widths...)
+	return top, middle, bottom
+}
+
+// widths returns the width of every visible column within the table, including
+// the index column if row numbers are enabled, and the hidden column indicator
+func (t *Table) widths() []int {
+	widths := make([]int, 0, len(t.visible)+2)
+	if t.index != nil {
+		widths = append(widths, t.indexWidth())
+	}
+
+	for _, col := range t.visible {
+		widths = append(widths, t.colWidths[col])
+	}
+
+	if t.indicator && t.hidden() {
+		widths = append(widths, lipgloss.Width(t.indicatorCell()))
+	}
+	return widths
+}
+
+// tableBorder resolves the border of the table, downgrading it to ASCII if the
+// terminal does not support unicode or renders box-drawing characters as wide
+func (t *Table) tableBorder() TableBorder {
+	if !hasUnicode.Load() || ambiguousWide() {
+		return t.border.ASCII()
+	}
+	return t.border
+}
+
+func (t *Table) divider(left, sep, join, right string, cellW ...int) string {
 	var d strings.Builder
 	for _, mw := range cellW {
 		d.WriteString(strings.Repeat(sep, mw) + join)
@@ -144,21 +386,36 @@ func divider(left, sep, join, right string, cellW ...int) string {
 
 	return lipgloss.JoinHorizontal(
 		lipgloss.Left,
-		bdr.Render(left),
-		bdr.Render(mid),
-		bdr.Render(right),
+		t.theme.BorderStyle.Render(left),
+		t.theme.BorderStyle.Render(mid),
+		t.theme.BorderStyle.Render(right),
 	)
 }
 
-func verticalDivider(str string, h int) string {
+func (t *Table) verticalDivider(str string, h int) string {
 	d := strings.Repeat(str+"\n", h)
-	return bdr.Render(strings.TrimRight(d, "\n"))
+	return t.theme.BorderStyle.Render(strings.TrimRight(d, "\n"))
+}
+
+// Theme sets the theme used to style the borders and cells of the table. As the
+// theme defines the default padding of each cell, any padding set on the table
+// will be reset
+//
+//	theme.NewTable(data).Theme(theme.NewTheme(palette))
+func (t *Table) Theme(th *Theme) *Table {
+	t.theme = th
+	t.resetPaddings()
+	t.maxDimensions()
+	return t
 }
 
-// Border sets the table border
+// Border sets the table border. If the terminal does not support unicode, the
+// border will be transparently downgraded to its ASCII equivalent
 func (t *Table) Border(border TableBorder) *Table {
 	t.border = border
-	t.resetDividers()
+	if t.totalWidth > 0 {
+		t.resolveWidths()
+	}
 	return t
 }
 
@@ -167,27 +424,50 @@ func (t *Table) Border(border TableBorder) *Table {
 // If more than one argument is provided, each corresponding columns width
 // will be fixed in turn.
 func (t *Table) Widths(w ...int) *Table {
-	t.resetMaxWidths(w...)
-	t.resetDividers()
-	return t
-}
-
-func (t *Table) resetMaxWidths(w ...int) {
 	if len(w) == 0 {
-		return
+		return t
 	}
 
-	if len(w) == 1 {
-		for i := 0; i < len(t.colWidths); i++ {
-			t.colWidths[i] = w[0]
-		}
-		return
+	specs := make([]ColumnWidth, 0, len(w))
+	for _, cw := range w {
+		specs = append(specs, FixedWidth(cw))
 	}
+	return t.ColumnWidths(specs...)
+}
 
-	cols := min(len(t.colWidths), len(w))
-	for i := 0; i < cols; i++ {
-		t.colWidths[i] = w[i]
+// ColumnWidths sets how the width of each column within the table is resolved,
+// supporting a mix of fixed, percentage, flexible and content sized columns. All
+// columns will adopt the same width when only one argument is set. Each column
+// will adopt its own width if more than one argument is set. If the number of
+// widths is less than the number of columns, then those columns will be sized
+// around their content
+//
+//	theme.NewTable(data).
+//		TotalWidth(80).
+//		ColumnWidths(
+//			theme.FixedWidth(8),
+//			theme.FlexWidth(1).Min(20),
+//			theme.PercentWidth(25),
+//		)
+func (t *Table) ColumnWidths(w ...ColumnWidth) *Table {
+	t.resetErrors("widths")
+	t.checkWidths(w)
+	t.widthSpecs = w
+	t.resolveWidths()
+	return t
+}
+
+// TotalWidth sets the target width of the entire table, including any borders.
+// Any percentage or flexible column widths are resolved against it. If the table
+// would overflow, any columns sized around their content are shrunk to fit
+func (t *Table) TotalWidth(w int) *Table {
+	t.resetErrors("total width")
+	if w < 0 {
+		t.invalid("total width", "expected a width of at least 0, got %d", w)
 	}
+	t.totalWidth = w
+	t.resolveWidths()
+	return t
 }
 
 // HorizontalAlignments is a shorthand method for setting the horizontal alignment of
@@ -196,6 +476,8 @@ func (t *Table) resetMaxWidths(w ...int) {
 // argument is set. If the number of alignments is less than the number of columns,
 // then those columns remain untouched
 func (t *Table) HorizontalAlignments(p ...lipgloss.Position) *Table {
+	t.resetErrors("horizontal alignments")
+	t.checkColumns("horizontal alignments", len(p))
 	t.setAlignments(0, p...)
 	return t
 }
@@ -206,6 +488,8 @@ func (t *Table) HorizontalAlignments(p ...lipgloss.Position) *Table {
 // argument is set. If the number of alignments is less than the number of columns,
 // then those columns remain untouched
 func (t *Table) VerticalAlignments(p ...lipgloss.Position) *Table {
+	t.resetErrors("vertical alignments")
+	t.checkColumns("vertical alignments", len(p))
 	t.setAlignments(1, p...)
 	return t
 }
@@ -228,6 +512,41 @@ func (t *Table) setAlignments(pos int, p ...lipgloss.Position) {
 	}
 }
 
+// Directions is a shorthand method for setting the reading direction of columns
+// within the table. All columns will adopt the same direction when only one argument
+// is set. Each column will adopt its own direction if more than one argument is set.
+// If the number of directions is less than the number of columns, then those columns
+// remain untouched. Horizontal alignments of a [RightToLeft] column are mirrored, so
+// [lipgloss.Left] aligns text to the start of the column
+func (t *Table) Directions(d ...Direction) *Table {
+	t.resetErrors("directions")
+	t.checkColumns("directions", len(d))
+	if len(d) == 0 {
+		return t
+	}
+
+	if len(d) == 1 {
+		for i := 0; i < len(t.directions); i++ {
+			t.directions[i] = d[0]
+		}
+		return t
+	}
+
+	cols := min(len(t.directions), len(d))
+	for i := 0; i < cols; i++ {
+		t.directions[i] = d[i]
+	}
+	return t
+}
+
+// BidiIsolation controls whether the contents of each cell should be wrapped
+// within a unicode bidi isolate. This prevents terminals that support bidirectional
+// text from reordering borders and neighbouring cells around any right-to-left text
+func (t *Table) BidiIsolation(on bool) *Table {
+	t.isolated = on
+	return t
+}
+
 // Dividers controls whether a row divider should be rendered
 // between all table rows
 func (t *Table) Dividers(on bool) *Table {
@@ -243,42 +562,302 @@ func (t *Table) Collapsed(on bool) *Table {
 	return t
 }
 
+// Padding sets the internal padding of every cell within the table, following
+// the same shorthand as [lipgloss.Style.Padding]. With one argument, the padding
+// is applied to all sides. With two arguments, the padding is applied to the
+// vertical and then horizontal sides. With three arguments, the padding is applied
+// to the top, horizontal and then bottom sides. With four arguments, the padding is
+// applied clockwise starting from the top. By default, cells are padded by a single
+// space on their left and right sides
+func (t *Table) Padding(p ...int) *Table {
+	t.resetErrors("padding")
+	t.checkPadding("padding", p)
+	for i := range t.paddings {
+		t.setPadding(i, p...)
+	}
+	t.maxDimensions()
+	return t
+}
+
+// ColumnPadding sets the internal padding of every cell within a given column,
+// following the same shorthand as [Table.Padding]
+func (t *Table) ColumnPadding(col int, p ...int) *Table {
+	if !t.checkColumn("column padding", col) {
+		return t
+	}
+	t.checkPadding(fmt.Sprintf("column padding: column %d", col), p)
+
+	t.setPadding(col, p...)
+	t.maxDimensions()
+	return t
+}
+
+func (t *Table) setPadding(col int, p ...int) {
+	switch len(p) {
+	case 1:
+		t.paddings[col] = padding{top: p[0], right: p[0], bottom: p[0], left: p[0]}
+	case 2:
+		t.paddings[col] = padding{top: p[0], right: p[1], bottom: p[0], left: p[1]}
+	case 3:
+		t.paddings[col] = padding{top: p[0], right: p[1], bottom: p[2], left: p[1]}
+	case 4:
+		t.paddings[col] = padding{top: p[0], right: p[1], bottom: p[2], left: p[3]}
+	}
+}
+
+// RowSpacing sets the number of blank lines rendered between each row within the
+// table. As spacing takes the place of row dividers, it is only rendered when
+// dividers have been disabled
+func (t *Table) RowSpacing(n int) *Table {
+	t.resetErrors("row spacing")
+	if n < 0 {
+		t.invalid("row spacing", "expected spacing of at least 0, got %d", n)
+	}
+	t.spacing = max(n, 0)
+	return t
+}
+
+// Priorities sets the priority of columns within the table. When the table cannot
+// fit within its [Table.TotalWidth], columns with the lowest priority are hidden
+// first, until it does. All columns will adopt the same priority when only one
+// argument is set. Each column will adopt its own priority if more than one argument
+// is set. If the number of priorities is less than the number of columns, then those
+// columns remain untouched. By default, all columns have a priority of 0
+func (t *Table) Priorities(p ...int) *Table {
+	t.resetErrors("priorities")
+	t.checkColumns("priorities", len(p))
+	if len(p) == 0 {
+		return t
+	}
+
+	if len(p) == 1 {
+		for i := 0; i < len(t.priorities); i++ {
+			t.priorities[i] = p[0]
+		}
+	} else {
+		cols := min(len(t.priorities), len(p))
+		copy(t.priorities[:cols], p[:cols])
+	}
+
+	if t.totalWidth > 0 {
+		t.resolveWidths()
+	}
+	return t
+}
+
+// HiddenIndicator controls whether an additional column should be rendered
+// to indicate that columns have been hidden from the table
+func (t *Table) HiddenIndicator(on bool) *Table {
+	t.indicator = on
+	if t.totalWidth > 0 {
+		t.resolveWidths()
+	}
+	return t
+}
+
+func (t *Table) indicatorCell() string {
+	return t.indexStyle().Render(glyph("…", "..."))
+}
+
+// Order reorders the columns within the table by their key, taken from the first
+// row of the table. Columns are rendered in the order given, followed by any remaining
+// columns in their original order. Unknown keys are skipped and reported by [Table.Validate].
+// As the table data is left untouched, all column based configuration, such as
+// [Table.Widths], remains unaffected
+//
+//	theme.NewTable(data).Order("Madness Rating", "Name")
+func (t *Table) Order(keys ...string) *Table {
+	if len(t.data) == 0 {
+		return t
+	}
+
+	t.resetErrors("order")
+	cols := make(map[string]int, len(t.data[0]))
+	for i, key := range t.data[0] {
+		if _, ok := cols[key]; !ok {
+			cols[key] = i
+		}
+	}
+
+	order := make([]int, 0, len(t.data[0]))
+	ordered := make(map[int]bool, len(keys))
+	for _, key := range keys {
+		col, ok := cols[key]
+		if !ok {
+			t.invalid("order", "unknown column key %q", key)
+			continue
+		}
+
+		if !ordered[col] {
+			order = append(order, col)
+			ordered[col] = true
+		}
+	}
+
+	for i := range t.data[0] {
+		if !ordered[i] {
+			order = append(order, i)
+		}
+	}
+
+	t.order = order
+	t.visible = order
+	t.maxDimensions()
+	return t
+}
+
+// RowNumbers prepends an auto-generated index column to the table, numbering
+// each row from the given start, typically 0 or 1. If a header is provided, it
+// is rendered within the first row of the table, which is then excluded from
+// the numbering. The index column is not part of the table data, so all column
+// based configuration, such as [Table.Widths], remains unaffected. Numbers will
+// always follow the order in which rows are rendered
+//
+//	theme.NewTable(data).RowNumbers(1, "#")
+func (t *Table) RowNumbers(start int, header string) *Table {
+	t.index = &rowIndex{start: start, header: header}
+	if t.totalWidth > 0 {
+		t.resolveWidths()
+	}
+	return t
+}
+
+func (t *Table) indexLabel(row int) string {
+	if t.index.header == "" {
+		return strconv.Itoa(t.index.start + row)
+	}
+
+	if row == 0 {
+		return t.index.header
+	}
+	return strconv.Itoa(t.index.start + row - 1)
+}
+
+func (t *Table) indexStyle() lipgloss.Style {
+	idx := t.theme.renderer.NewStyle().Faint(true)
+	if t.collapsed {
+		return idx
+	}
+	return t.theme.CellStyle.Inherit(idx)
+}
+
+func (t *Table) indexWidth() int {
+	w := 0
+	for i := range t.data {
+		w = max(w, lipgloss.Width(t.indexStyle().Render(t.indexLabel(i))))
+	}
+	return w
+}
+
 // String renders the table as a formatted string
 func (t *Table) String() string {
-	if len(t.data) == 0 {
+	if len(t.data) == 0 || len(t.data[0]) == 0 {
 		return ""
 	}
 
-	cellStyle := cell
-	if t.collapsed {
-		cellStyle = cellStyle.UnsetPadding()
+	if t.records {
+		return t.renderRecords()
 	}
 
-	var tblRows []string
-	for i, row := range t.data {
-		var tblRow []string
+	// Resolve the border once, so every part of the table is rendered with
+	// the same characters
+	border := t.tableBorder()
 
-		rowH := t.rowHeights[i]
-		vertJoin := verticalDivider(t.border.Vertical, rowH)
+	// Measuring the index column visits every row, so is only done once
+	widths := t.widths()
+	indexW := 0
+	if t.index != nil {
+		indexW = widths[0]
+	}
 
-		for j, col := range row {
-			cell := cellStyle.Width(t.colWidths[j]).
-				Align(t.alignments[j]...).
-				Render(col)
+	top, middle, bottom := t.rowDividers(border, widths)
+	rows := t.treeRows()
 
-			tblRow = append(tblRow, vertJoin, cell)
+	var tblRows []string
+	for pos, r := range rows {
+		tblRows = append(tblRows, t.renderRow(pos, r, border, indexW))
+		if pos == len(rows)-1 {
+			continue
 		}
-		tblRow = append(tblRow, vertJoin)
-		tblRows = append(tblRows, lipgloss.JoinHorizontal(lipgloss.Left, tblRow...))
-		if t.dividers && i < len(t.data)-1 {
-			tblRows = append(tblRows, t.middle)
+
+		if t.dividers {
+			tblRows = append(tblRows, middle)
+		} else if t.spacing > 0 {
+			tblRows = append(tblRows, t.spacer(border, widths))
 		}
 	}
 
 	return lipgloss.JoinVertical(
 		lipgloss.Top,
-		t.top,
+		top,
 		strings.Join(tblRows, "\n"),
-		t.bottom,
+		bottom,
 	)
 }
+
+// renderRow renders each cell within a row to its column width. As wrapping can
+// only be determined once a cell is rendered, the height of the row is resolved
+// from its tallest cell, ensuring all borders remain aligned
+func (t *Table) renderRow(pos int, r treeRow, border TableBorder, indexW int) string {
+	i := r.row
+	rowH := t.rowHeights[i]
+	cells := make([]string, 0, len(t.visible))
+	for _, j := range t.visible {
+		hAlign := t.alignments[j][0]
+		if t.directions[j] == RightToLeft {
+			hAlign = 1 - hAlign
+		}
+
+		contentW := t.colWidths[j] - t.cellStyle(j).GetHorizontalPadding()
+		content := t.content(i, j, contentW)
+		if j == t.treeCol() {
+			content = t.withGuides(content, contentW, r)
+		}
+
+		c := t.cellStyle(j).Width(t.colWidths[j]).
+			MaxWidth(t.colWidths[j]).
+			Align(hAlign, t.alignments[j][1]).
+			Render(content)
+
+		rowH = max(rowH, lipgloss.Height(c))
+		cells = append(cells, c)
+	}
+
+	vertJoin := t.verticalDivider(border.Vertical, rowH)
+
+	tblRow := make([]string, 0, len(cells)*2+5)
+	if t.index != nil {
+		label := t.indexStyle().
+			Width(indexW).
+			Align(lipgloss.Right).
+			Render(t.indexLabel(pos))
+		tblRow = append(tblRow, vertJoin, label)
+	}
+
+	for k, c := range cells {
+		j := t.visible[k]
+		c = lipgloss.PlaceVertical(rowH, t.alignments[j][1], c)
+		if t.isolated {
+			c = isolate(c, t.directions[j])
+		}
+		tblRow = append(tblRow, vertJoin, c)
+	}
+
+	if t.indicator && t.hidden() {
+		tblRow = append(tblRow, vertJoin, t.indicatorCell())
+	}
+	tblRow = append(tblRow, vertJoin)
+	return lipgloss.JoinHorizontal(lipgloss.Left, tblRow...)
+}
+
+// spacer renders a blank row that retains all vertical borders of the table
+func (t *Table) spacer(border TableBorder, widths []int) string {
+	vertJoin := t.verticalDivider(border.Vertical, t.spacing)
+
+	spacer := make([]string, 0, len(widths)*2+1)
+	for _, w := range widths {
+		spacer = append(spacer, vertJoin, t.theme.renderer.NewStyle().Width(w).Height(t.spacing).Render())
+	}
+	spacer = append(spacer, vertJoin)
+	return lipgloss.JoinHorizontal(lipgloss.Left, spacer...)
+}
@@ -2,6 +2,8 @@ package theme_test
 
 import (
 	"os"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/charmbracelet/lipgloss"
@@ -24,8 +26,15 @@ var data = [][]string{
 }
 
 func TestMain(m *testing.M) {
+	// Ignore any color or contrast settings within the environment of the developer
+	for _, env := range []string{"NO_COLOR", "CLICOLOR", "CLICOLOR_FORCE", "FORCE_COLOR", "PURPLECLAY_CONTRAST"} {
+		os.Unsetenv(env)
+	}
+	theme.ResetDefault()
+
 	// Strip all color related to the theme as it is breaking golden tests
 	lipgloss.SetColorProfile(termenv.Ascii)
+	theme.SetUnicodeSupported(true)
 	code := m.Run()
 	os.Exit(code)
 }
@@ -69,6 +78,110 @@ func TestTableBorder(t *testing.T) {
 	}
 }
 
+func TestTableASCIIFallback(t *testing.T) {
+	defer theme.SetUnicodeSupported(false)()
+
+	tbl := theme.NewTable(data).Border(theme.RoundedThinBorder)
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
+func TestTableUnicodeToggledAfterCreation(t *testing.T) {
+	tbl := theme.NewTable(data).Border(theme.RoundedThinBorder)
+
+	defer theme.SetUnicodeSupported(false)()
+
+	for _, r := range tbl.String() {
+		if r > 127 {
+			t.Fatalf("expected an ASCII border, found %q within:\n%s", r, tbl.String())
+		}
+	}
+}
+
+func TestTableUnicodeSettingsRestored(t *testing.T) {
+	tbl := theme.NewTable(data).Border(theme.RoundedThinBorder)
+	expected := tbl.String()
+
+	theme.SetUnicodeSupported(false)()
+	theme.SetAmbiguousWidth(2)()
+
+	if !theme.UnicodeSupported() {
+		t.Errorf("expected unicode support to be restored")
+	}
+
+	if got := tbl.String(); got != expected {
+		t.Errorf("expected the unicode border to be restored, got:\n%s", got)
+	}
+}
+
+var unicodeData = [][]string{
+	{"名前", "説明", "絵文字"},
+	{"東京", "日本の首都であり、世界最大の都市圏の一つです", "🗼 tower"},
+	{"Zoë", "Combining marks: e\u0301 a\u0308 n\u0303", "👨‍👩‍👧‍👦 family"},
+	{"Variation", "Text ☺︎ vs emoji ☺️ presentation", "❤️ heart 🇬🇧 flag"},
+	{"Ambiguous", "± ½ Ω ① §", "👍🏽 thumbs up"},
+}
+
+func TestTableUnicodeWidths(t *testing.T) {
+	tests := []struct {
+		name   string
+		widths []int
+	}{
+		{
+			name: "Natural",
+		},
+		{
+			name:   "Wrapped",
+			widths: []int{8, 16, 10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := theme.NewTable(unicodeData).
+				Border(theme.ThinBorder).
+				Widths(tt.widths...)
+
+			golden.RequireEqual(t, []byte(tbl.String()))
+		})
+	}
+}
+
+func TestTableAmbiguousWidth(t *testing.T) {
+	defer theme.SetAmbiguousWidth(2)()
+
+	tbl := theme.NewTable(unicodeData).Border(theme.ThinBorder)
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
+var rtlData = [][]string{
+	{"City", "עִיר", "مدينة"},
+	{"Jerusalem", "ירושלים", "القدس"},
+	{"Tel Aviv", "תל אביב", "تل أبيب"},
+	{"Cairo", "קהיר", "القاهرة"},
+}
+
+func TestTableDirections(t *testing.T) {
+	t.Parallel()
+	tbl := theme.NewTable(rtlData).
+		Border(theme.ThinBorder).
+		Widths(12).
+		HorizontalAlignments(lipgloss.Left, lipgloss.Left, lipgloss.Right).
+		Directions(theme.LeftToRight, theme.RightToLeft, theme.RightToLeft)
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
+func TestTableBidiIsolation(t *testing.T) {
+	t.Parallel()
+	tbl := theme.NewTable(rtlData).
+		Border(theme.ThinBorder).
+		Directions(theme.LeftToRight, theme.RightToLeft, theme.RightToLeft).
+		BidiIsolation(true)
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
 func TestTableNoDividers(t *testing.T) {
 	t.Parallel()
 	tbl := theme.NewTable(data).
@@ -86,6 +199,35 @@ func TestTableCollapsed(t *testing.T) {
 	golden.RequireEqual(t, []byte(tbl.String()))
 }
 
+func TestTablePadding(t *testing.T) {
+	t.Parallel()
+	tbl := theme.NewTable(data).
+		Border(theme.ThinBorder).
+		Padding(1, 2)
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
+func TestTableColumnPadding(t *testing.T) {
+	t.Parallel()
+	tbl := theme.NewTable(data).
+		Border(theme.ThinBorder).
+		ColumnPadding(0, 0, 3, 0, 1).
+		ColumnPadding(3, 0)
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
+func TestTableRowSpacing(t *testing.T) {
+	t.Parallel()
+	tbl := theme.NewTable(data).
+		Border(theme.ThinBorder).
+		Dividers(false).
+		RowSpacing(1)
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
 func TestTableWidths(t *testing.T) {
 	t.Parallel()
 	tbl := theme.NewTable(data).
@@ -95,6 +237,311 @@ func TestTableWidths(t *testing.T) {
 	golden.RequireEqual(t, []byte(tbl.String()))
 }
 
+func TestTableColumnWidths(t *testing.T) {
+	tests := []struct {
+		name   string
+		total  int
+		widths []theme.ColumnWidth
+	}{
+		{
+			name:  "FixedAutoFlex",
+			total: 90,
+			widths: []theme.ColumnWidth{
+				theme.FixedWidth(14),
+				theme.AutoWidth(),
+				theme.FlexWidth(1),
+				theme.AutoWidth(),
+			},
+		},
+		{
+			name:  "FlexWeights",
+			total: 100,
+			widths: []theme.ColumnWidth{
+				theme.FlexWidth(1),
+				theme.FlexWidth(1),
+				theme.FlexWidth(2),
+				theme.FlexWidth(1),
+			},
+		},
+		{
+			name:  "PercentWithMin",
+			total: 80,
+			widths: []theme.ColumnWidth{
+				theme.PercentWidth(20),
+				theme.PercentWidth(5).Min(8),
+				theme.PercentWidth(50),
+				theme.FlexWidth(1),
+			},
+		},
+		{
+			name: "FlexWithoutTotal",
+			widths: []theme.ColumnWidth{
+				theme.FlexWidth(1).Min(16),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := theme.NewTable(data).
+				Border(theme.ThinBorder).
+				TotalWidth(tt.total).
+				ColumnWidths(tt.widths...)
+
+			out := tbl.String()
+			if tt.total > 0 && lipgloss.Width(out) != tt.total {
+				t.Errorf("expected table width of %d, got %d", tt.total, lipgloss.Width(out))
+			}
+			golden.RequireEqual(t, []byte(out))
+		})
+	}
+}
+
+func TestTableRowNumbers(t *testing.T) {
+	tests := []struct {
+		name   string
+		start  int
+		header string
+	}{
+		{
+			name:   "WithHeader",
+			start:  1,
+			header: "#",
+		},
+		{
+			name:  "ZeroBased",
+			start: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := theme.NewTable(data).
+				Border(theme.ThinBorder).
+				RowNumbers(tt.start, tt.header).
+				Widths(14, 8, 30, 10)
+
+			golden.RequireEqual(t, []byte(tbl.String()))
+		})
+	}
+}
+
+func TestTablePriorities(t *testing.T) {
+	tests := []struct {
+		name      string
+		total     int
+		indicator bool
+	}{
+		{
+			name:  "Wide",
+			total: 120,
+		},
+		{
+			name:  "Narrow",
+			total: 60,
+		},
+		{
+			name:      "NarrowWithIndicator",
+			total:     60,
+			indicator: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := theme.NewTable(data).
+				Border(theme.ThinBorder).
+				Priorities(3, 1, 0, 2).
+				HiddenIndicator(tt.indicator).
+				TotalWidth(tt.total)
+
+			golden.RequireEqual(t, []byte(tbl.String()))
+		})
+	}
+}
+
+func TestTableOrder(t *testing.T) {
+	t.Parallel()
+	tbl := theme.NewTable(data).
+		Border(theme.ThinBorder).
+		Widths(14, 8, 30, 10).
+		Order("Madness Rating", "Name")
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
+func TestTableRecords(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  [][]string
+		total int
+		index bool
+		order []string
+	}{
+		{
+			name: "Natural",
+			data: data,
+		},
+		{
+			name:  "TotalWidth",
+			data:  data,
+			total: 50,
+		},
+		{
+			name:  "RowNumbers",
+			data:  data,
+			index: true,
+		},
+		{
+			name:  "Order",
+			data:  data,
+			order: []string{"Madness Rating", "Name"},
+		},
+		{
+			name: "HeaderOnly",
+			data: data[:1],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := theme.NewTable(tt.data).
+				Border(theme.ThinBorder).
+				TotalWidth(tt.total).
+				Order(tt.order...).
+				Records(true)
+
+			if tt.index {
+				tbl.RowNumbers(1, "#")
+			}
+
+			golden.RequireEqual(t, []byte(tbl.String()))
+		})
+	}
+}
+
+func TestTableNested(t *testing.T) {
+	t.Parallel()
+	inner := theme.NewTable([][]string{
+		{"Alias", "Real Name"},
+		{"The Joker", "Unknown"},
+		{"Two-Face", "Harvey Dent"},
+	}).
+		Border(theme.RoundedThinBorder).
+		ColumnWidths(theme.FlexWidth(1), theme.FlexWidth(2))
+
+	tbl := theme.NewTable([][]string{
+		{"Villains", ""},
+		{"Notes", "Identities are only partially known"},
+	}).
+		Border(theme.ThinBorder).
+		TotalWidth(70).
+		ColumnWidths(theme.AutoWidth(), theme.FlexWidth(1)).
+		VerticalAlignments(lipgloss.Center).
+		Nest(0, 1, inner)
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
+func TestTableNestedAutoWidth(t *testing.T) {
+	t.Parallel()
+	inner := theme.NewTable(data[:3]).Border(theme.RoundedThinBorder)
+
+	tbl := theme.NewTable([][]string{
+		{"Villains", ""},
+		{"Notes", "Identities are only partially known"},
+	}).
+		Border(theme.ThinBorder).
+		TotalWidth(70).
+		ColumnWidths(theme.AutoWidth(), theme.FlexWidth(1)).
+		Nest(0, 1, inner)
+
+	out := tbl.String()
+	if lipgloss.Width(out) != 70 {
+		t.Errorf("expected table width of 70, got %d", lipgloss.Width(out))
+	}
+	golden.RequireEqual(t, []byte(out))
+}
+
+func TestTableRenderWidthLeavesTableUntouched(t *testing.T) {
+	t.Parallel()
+	tbl := theme.NewTable(data).RowSpacing(-1).TotalWidth(-1).Padding(-1)
+	before := tbl.Validate().Error()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tbl.RenderWidth(-1)
+		}()
+	}
+	wg.Wait()
+
+	if after := tbl.Validate().Error(); after != before {
+		t.Errorf("expected validation errors to be unchanged:\n%s\ngot:\n%s", before, after)
+	}
+}
+
+var treeData = [][]string{
+	{"Path", "Size"},
+	{"src", "1.2 MB"},
+	{"main.go", "4 KB"},
+	{"internal", "1.1 MB"},
+	{"table.go", "12 KB"},
+	{"style.go", "6 KB"},
+	{"docs", "300 KB"},
+	{"README.md", "2 KB"},
+}
+
+func TestTableTree(t *testing.T) {
+	tests := []struct {
+		name    string
+		markers bool
+		fold    bool
+	}{
+		{
+			name: "Expanded",
+		},
+		{
+			name:    "FoldMarkers",
+			markers: true,
+		},
+		{
+			name:    "Folded",
+			markers: true,
+			fold:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tbl := theme.NewTable(treeData).
+				Border(theme.ThinBorder).
+				Dividers(false).
+				HorizontalAlignments(lipgloss.Left, lipgloss.Right).
+				Parent(2, 1).
+				Parent(3, 1).
+				Parent(4, 3).
+				Parent(5, 3).
+				Parent(7, 6).
+				FoldMarkers(tt.markers).
+				Fold(3, tt.fold)
+
+			golden.RequireEqual(t, []byte(tbl.String()))
+		})
+	}
+}
+
+func TestTableTreeCycle(t *testing.T) {
+	t.Parallel()
+	out := theme.NewTable(treeData).
+		Parent(1, 2).
+		Parent(2, 1).
+		String()
+
+	for _, row := range treeData {
+		if !strings.Contains(out, row[0]) {
+			t.Errorf("expected row %q to be rendered within:\n%s", row[0], out)
+		}
+	}
+}
+
 func TestTableHorizontalAlignments(t *testing.T) {
 	t.Parallel()
 	tbl := theme.NewTable(data).
@@ -0,0 +1,360 @@
+package theme
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+)
+
+// Palette defines the color shades that every style within a [Theme] is derived
+// from. Shades range from the lightest (50) to the darkest (950)
+type Palette struct {
+	S950 lipgloss.Color
+	S900 lipgloss.Color
+	S800 lipgloss.Color
+	S700 lipgloss.Color
+	S600 lipgloss.Color
+	S500 lipgloss.Color
+	S400 lipgloss.Color
+	S300 lipgloss.Color
+	S200 lipgloss.Color
+	S100 lipgloss.Color
+	S50  lipgloss.Color
+
+	Green950 lipgloss.Color
+	Green900 lipgloss.Color
+	Green800 lipgloss.Color
+	Green700 lipgloss.Color
+	Green600 lipgloss.Color
+	Green500 lipgloss.Color
+	Green400 lipgloss.Color
+	Green300 lipgloss.Color
+	Green200 lipgloss.Color
+	Green100 lipgloss.Color
+	Green50  lipgloss.Color
+
+	Amber950 lipgloss.Color
+	Amber900 lipgloss.Color
+	Amber800 lipgloss.Color
+	Amber700 lipgloss.Color
+	Amber600 lipgloss.Color
+	Amber500 lipgloss.Color
+	Amber400 lipgloss.Color
+	Amber300 lipgloss.Color
+	Amber200 lipgloss.Color
+	Amber100 lipgloss.Color
+	Amber50  lipgloss.Color
+
+	Red950 lipgloss.Color
+	Red900 lipgloss.Color
+	Red800 lipgloss.Color
+	Red700 lipgloss.Color
+	Red600 lipgloss.Color
+	Red500 lipgloss.Color
+	Red400 lipgloss.Color
+	Red300 lipgloss.Color
+	Red200 lipgloss.Color
+	Red100 lipgloss.Color
+	Red50  lipgloss.Color
+
+	Blue950 lipgloss.Color
+	Blue900 lipgloss.Color
+	Blue800 lipgloss.Color
+	Blue700 lipgloss.Color
+	Blue600 lipgloss.Color
+	Blue500 lipgloss.Color
+	Blue400 lipgloss.Color
+	Blue300 lipgloss.Color
+	Blue200 lipgloss.Color
+	Blue100 lipgloss.Color
+	Blue50  lipgloss.Color
+}
+
+// PurpleClay defines the PurpleClay palette of color shades
+var PurpleClay = Palette{
+	S950:     S950,
+	S900:     S900,
+	S800:     S800,
+	S700:     S700,
+	S600:     S600,
+	S500:     S500,
+	S400:     S400,
+	S300:     S300,
+	S200:     S200,
+	S100:     S100,
+	S50:      S50,
+	Green950: Green950,
+	Green900: Green900,
+	Green800: Green800,
+	Green700: Green700,
+	Green600: Green600,
+	Green500: Green500,
+	Green400: Green400,
+	Green300: Green300,
+	Green200: Green200,
+	Green100: Green100,
+	Green50:  Green50,
+	Amber950: Amber950,
+	Amber900: Amber900,
+	Amber800: Amber800,
+	Amber700: Amber700,
+	Amber600: Amber600,
+	Amber500: Amber500,
+	Amber400: Amber400,
+	Amber300: Amber300,
+	Amber200: Amber200,
+	Amber100: Amber100,
+	Amber50:  Amber50,
+	Red950:   Red950,
+	Red900:   Red900,
+	Red800:   Red800,
+	Red700:   Red700,
+	Red600:   Red600,
+	Red500:   Red500,
+	Red400:   Red400,
+	Red300:   Red300,
+	Red200:   Red200,
+	Red100:   Red100,
+	Red50:    Red50,
+	Blue950:  Blue950,
+	Blue900:  Blue900,
+	Blue800:  Blue800,
+	Blue700:  Blue700,
+	Blue600:  Blue600,
+	Blue500:  Blue500,
+	Blue400:  Blue400,
+	Blue300:  Blue300,
+	Blue200:  Blue200,
+	Blue100:  Blue100,
+	Blue50:   Blue50,
+}
+
+// Theme holds a palette and every style derived from it. Multiple themes can
+// be used side by side, with each one passed to the components that need it.
+// Glyphs are downgraded based on the unicode support of the terminal at the
+// time the theme is created. Any color settings within the environment are also
+// honoured, see [ColorDisabled] and [ForcedColorProfile]. Without color, styles
+// fall back to emphasis such as bold, underline and reverse
+//
+//	th := theme.Default()
+//	fmt.Println(th.H1.Render("Gotham"))
+type Theme struct {
+	Palette Palette
+	Tokens  Tokens
+
+	// A defines a themed hyperlink
+	A lipgloss.Style
+
+	// H1 to H6 define themed headers, ranked on importance from H1 (most)
+	// to H6 (least)
+	H1 lipgloss.Style
+	H2 lipgloss.Style
+	H3 lipgloss.Style
+	H4 lipgloss.Style
+	H5 lipgloss.Style
+	H6 lipgloss.Style
+
+	// Mark defines a themed text decoration for highlighting text
+	Mark lipgloss.Style
+
+	// I, U, B and S define themed italic, underline, bold and strikethrough
+	// text decorations
+	I lipgloss.Style
+	U lipgloss.Style
+	B lipgloss.Style
+	S lipgloss.Style
+
+	// Tick, Cross and Bang define themed glyphs ✓, ✕ and !
+	Tick  string
+	Cross string
+	Bang  string
+
+	// Logging defines a themed [logging] style
+	//
+	// [logging]: https://github.com/charmbracelet/log
+	Logging *log.Styles
+
+	// BorderStyle defines the style of all borders and tree guides within a [Table]
+	BorderStyle lipgloss.Style
+
+	// CellStyle defines the style of every cell within a [Table], including its
+	// default padding
+	CellStyle lipgloss.Style
+
+	// base is the renderer the theme was created with. Styles are bound to
+	// renderer, which is a copy of base if the environment changes color support
+	base     *lipgloss.Renderer
+	renderer *lipgloss.Renderer
+	noColor  bool
+
+	// variant records how the palette was mapped onto the theme, so it can be
+	// derived again with different tokens
+	variant variant
+}
+
+// Default returns a new instance of the PurpleClay theme. The high contrast
+// variant is returned instead if requested through the environment, see
+// [HighContrastEnabled]
+func Default() *Theme {
+	if HighContrastEnabled() {
+		return HighContrastTheme()
+	}
+	return NewTheme(PurpleClay)
+}
+
+// NewTheme creates a theme from the given palette, deriving every style from
+// its color shades. All styles support both light and dark terminals
+func NewTheme(p Palette) *Theme {
+	return NewThemeWithRenderer(lipgloss.DefaultRenderer(), p)
+}
+
+// NewThemeWithRenderer creates a theme from the given palette, with every style
+// bound to the renderer. Styles will adopt the color profile and background of
+// its output, rather than that of the default renderer. Needed when rendering
+// for multiple outputs, such as each session of an SSH server
+//
+//	func handler(sess ssh.Session) {
+//		r := bubbletea.MakeRenderer(sess)
+//		th := theme.NewThemeWithRenderer(r, theme.PurpleClay)
+//	}
+func NewThemeWithRenderer(r *lipgloss.Renderer, p Palette) *Theme {
+	return newTheme(r, p, standard(p))
+}
+
+// variant defines how the colors of a palette are mapped onto the styles of a theme
+type variant struct {
+	tokens Tokens
+
+	// headers and headerText set the background and text color of each header,
+	// from H1 to H6
+	headers    [6]lipgloss.TerminalColor
+	headerText lipgloss.TerminalColor
+
+	// markText sets the color of highlighted text. If empty, it is inherited
+	// from the terminal
+	markText lipgloss.AdaptiveColor
+}
+
+// WithTokens returns a copy of the theme, with every style derived again from the
+// given tokens. Headers keep the colors of the original theme
+func (th *Theme) WithTokens(tk Tokens) *Theme {
+	v := th.variant
+	v.tokens = tk
+	return newTheme(th.base, th.Palette, v)
+}
+
+func standard(p Palette) variant {
+	var headers [6]lipgloss.TerminalColor
+	for i, c := range []lipgloss.Color{p.S200, p.S300, p.S400, p.S500, p.S600, p.S700} {
+		headers[i] = Complete(c)
+	}
+
+	return variant{
+		tokens:     NewTokens(p),
+		headers:    headers,
+		headerText: lipgloss.Color("#ffffff"),
+	}
+}
+
+func newTheme(base *lipgloss.Renderer, p Palette, v variant) *Theme {
+	r := colorRenderer(base)
+	tk := v.tokens
+	h := r.NewStyle().Padding(0, 1).Bold(true).Foreground(v.headerText)
+
+	mark := r.NewStyle().
+		Padding(0, 1).
+		Background(CompleteAdaptive(tk.Surface))
+	if v.markText != (lipgloss.AdaptiveColor{}) {
+		mark = mark.Foreground(CompleteAdaptive(v.markText))
+	}
+
+	th := &Theme{
+		Palette: p,
+		Tokens:  tk,
+		variant: v,
+		A: r.NewStyle().
+			Bold(true).
+			Underline(true).
+			Foreground(CompleteAdaptive(tk.Link)),
+		H1:   h.Background(v.headers[0]),
+		H2:   h.Background(v.headers[1]),
+		H3:   h.Background(v.headers[2]),
+		H4:   h.Background(v.headers[3]),
+		H5:   h.Background(v.headers[4]),
+		H6:   h.Background(v.headers[5]),
+		Mark: mark,
+		I:    r.NewStyle().Italic(true),
+		U:    r.NewStyle().Underline(true),
+		B:    r.NewStyle().Bold(true),
+		S:    r.NewStyle().Strikethrough(true),
+		Logging: &log.Styles{
+			Timestamp: r.NewStyle(),
+			Caller:    r.NewStyle().Faint(true),
+			Prefix:    r.NewStyle().Bold(true).Faint(true),
+			Message:   r.NewStyle().MarginRight(2),
+			Key:       r.NewStyle().Foreground(CompleteAdaptive(tk.Primary)),
+			Value:     r.NewStyle(),
+			Separator: r.NewStyle().Faint(true),
+			Keys: map[string]lipgloss.Style{
+				"err":   r.NewStyle().Foreground(CompleteAdaptive(tk.Danger)),
+				"error": r.NewStyle().Foreground(CompleteAdaptive(tk.Danger)),
+			},
+			Values: map[string]lipgloss.Style{},
+		},
+		BorderStyle: r.NewStyle().Foreground(CompleteAdaptive(tk.Border)),
+		CellStyle:   r.NewStyle().Padding(0, 1),
+		base:        base,
+		renderer:    r,
+	}
+
+	th.resetGlyphs()
+	if ColorDisabled() {
+		th.withoutColor()
+	}
+	return th
+}
+
+// Renderer returns the renderer that the theme was created with. If color has
+// been disabled or forced within the environment, styles are instead bound to a
+// copy of it, leaving the renderer itself untouched
+func (th *Theme) Renderer() *lipgloss.Renderer {
+	return th.base
+}
+
+func (th *Theme) resetGlyphs() {
+	r := th.renderer
+
+	// Without color, glyphs are distinguished by their shape alone
+	accent := func(c lipgloss.AdaptiveColor) lipgloss.Style {
+		if th.noColor {
+			return r.NewStyle()
+		}
+		return r.NewStyle().Foreground(CompleteAdaptive(c))
+	}
+
+	th.Tick = accent(th.Tokens.Success).Render(glyph("✓", "v"))
+	th.Cross = accent(th.Tokens.Danger).Render(glyph("✕", "x"))
+	th.Bang = accent(th.Tokens.Warning).Render("!")
+
+	th.Logging.Levels = map[log.Level]lipgloss.Style{
+		log.DebugLevel: r.NewStyle().
+			SetString(th.Tick).
+			Bold(true).
+			MaxWidth(2),
+		log.InfoLevel: r.NewStyle().
+			SetString(th.Tick).
+			Bold(true).
+			MaxWidth(2),
+		log.WarnLevel: r.NewStyle().
+			SetString(th.Bang).
+			Bold(true).
+			MaxWidth(2),
+		log.ErrorLevel: r.NewStyle().
+			SetString(th.Cross).
+			Bold(true).
+			MaxWidth(2),
+		log.FatalLevel: r.NewStyle().
+			SetString(th.Cross).
+			Bold(true).
+			MaxWidth(2),
+	}
+}
@@ -0,0 +1,292 @@
+package theme_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/charmbracelet/x/exp/golden"
+	"github.com/muesli/termenv"
+	theme "github.com/purpleclay/lipgloss-theme"
+)
+
+var gotham = theme.Palette{
+	S950:     "#020617",
+	S900:     "#0f172a",
+	S800:     "#1e293b",
+	S700:     "#334155",
+	S600:     "#475569",
+	S500:     "#64748b",
+	S400:     "#94a3b8",
+	S300:     "#cbd5e1",
+	S200:     "#e2e8f0",
+	S100:     "#f1f5f9",
+	S50:      "#f8fafc",
+	Green900: "#14532d",
+	Green700: "#15803d",
+	Green600: "#16a34a",
+	Amber900: "#78350f",
+	Amber700: "#b45309",
+	Red900:   "#7f1d1d",
+	Red700:   "#b91c1c",
+	Red600:   "#dc2626",
+}
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+	th := theme.Default()
+
+	if th.Palette != theme.PurpleClay {
+		t.Errorf("expected the PurpleClay palette, got %v", th.Palette)
+	}
+
+	styles := map[string][2]lipgloss.Style{
+		"A":    {th.A, theme.A},
+		"H1":   {th.H1, theme.H1},
+		"H6":   {th.H6, theme.H6},
+		"Mark": {th.Mark, theme.Mark},
+		"Key":  {th.Logging.Key, theme.Logging.Key},
+	}
+	for name, s := range styles {
+		if s[0].GetForeground() != s[1].GetForeground() || s[0].GetBackground() != s[1].GetBackground() {
+			t.Errorf("%s: expected global style to alias the default theme", name)
+		}
+	}
+
+	if th.Tick != theme.Tick || th.Cross != theme.Cross || th.Bang != theme.Bang {
+		t.Errorf("expected global glyphs to alias the default theme")
+	}
+}
+
+func TestNewTheme(t *testing.T) {
+	t.Parallel()
+	th := theme.NewTheme(gotham)
+
+	if bg := th.H1.GetBackground(); bg != theme.Complete("#e2e8f0") {
+		t.Errorf("expected H1 background #e2e8f0, got %v", bg)
+	}
+
+	expected := lipgloss.AdaptiveColor{Light: "#7f1d1d", Dark: "#dc2626"}
+	if fg := th.Logging.Keys["err"].GetForeground(); fg != theme.CompleteAdaptive(expected) {
+		t.Errorf("expected err key foreground %v, got %v", expected, fg)
+	}
+}
+
+func TestThemesAreIndependent(t *testing.T) {
+	t.Parallel()
+	a := theme.Default()
+	b := theme.Default()
+
+	a.Logging.Keys["status"] = lipgloss.NewStyle().Bold(true)
+	if _, ok := b.Logging.Keys["status"]; ok {
+		t.Errorf("expected a change to one theme to not affect another")
+	}
+}
+
+// Any change to the colors of the default theme changes the look of every
+// consumer, so must be made on purpose by regenerating these goldens
+func TestThemeStyles(t *testing.T) {
+	tests := []struct {
+		name string
+		dark bool
+	}{
+		{
+			name: "Light",
+		},
+		{
+			name: "Dark",
+			dark: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := lipgloss.NewRenderer(io.Discard)
+			r.SetColorProfile(termenv.TrueColor)
+			r.SetHasDarkBackground(tt.dark)
+			th := theme.NewThemeWithRenderer(r, theme.PurpleClay)
+
+			styles := []struct {
+				name  string
+				style lipgloss.Style
+			}{
+				{name: "A", style: th.A},
+				{name: "H1", style: th.H1},
+				{name: "H2", style: th.H2},
+				{name: "H3", style: th.H3},
+				{name: "H4", style: th.H4},
+				{name: "H5", style: th.H5},
+				{name: "H6", style: th.H6},
+				{name: "Mark", style: th.Mark},
+				{name: "I", style: th.I},
+				{name: "U", style: th.U},
+				{name: "B", style: th.B},
+				{name: "S", style: th.S},
+				{name: "BorderStyle", style: th.BorderStyle},
+				{name: "Key", style: th.Logging.Key},
+				{name: "Value", style: th.Logging.Value},
+			}
+
+			var b strings.Builder
+			for _, s := range styles {
+				fmt.Fprintf(&b, "%-12s%s\n", s.name, s.style.Render("Gotham"))
+			}
+
+			for _, lvl := range []log.Level{log.DebugLevel, log.InfoLevel, log.WarnLevel, log.ErrorLevel, log.FatalLevel} {
+				fmt.Fprintf(&b, "%-12s%s\n", lvl, th.Logging.Levels[lvl].Render())
+			}
+			fmt.Fprintf(&b, "%-12s%s %s %s\n", "Glyphs", th.Tick, th.Cross, th.Bang)
+
+			golden.RequireEqual(t, []byte(b.String()))
+		})
+	}
+}
+
+func TestTableTheme(t *testing.T) {
+	t.Parallel()
+	th := theme.NewTheme(gotham)
+	th.CellStyle = th.CellStyle.Padding(0, 2)
+
+	tbl := theme.NewTable(data).
+		Border(theme.ThinBorder).
+		Theme(th)
+
+	golden.RequireEqual(t, []byte(tbl.String()))
+}
+
+func TestNewThemeWithRenderer(t *testing.T) {
+	t.Parallel()
+	r := lipgloss.NewRenderer(io.Discard)
+	r.SetColorProfile(termenv.TrueColor)
+	r.SetHasDarkBackground(false)
+
+	th := theme.NewThemeWithRenderer(r, theme.PurpleClay)
+	if th.Renderer() != r {
+		t.Fatalf("expected theme to be bound to the renderer")
+	}
+
+	tests := []struct {
+		name     string
+		rendered string
+		expected string
+	}{
+		{
+			name:     "Mark",
+			rendered: th.Mark.Render("Gotham"),
+			expected: "\x1b[48;2;169;128;219m",
+		},
+		{
+			name:     "Tick",
+			rendered: th.Tick,
+			expected: "\x1b[38;2;8;115;48m",
+		},
+		{
+			name:     "Logging",
+			rendered: th.Logging.Key.Render("status"),
+			expected: "\x1b[38;2;72;0;159m",
+		},
+		{
+			name:     "Table",
+			rendered: theme.NewTable(data).Border(theme.ThinBorder).Theme(th).String(),
+			expected: "\x1b[38;2;72;0;159m┌",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(tt.rendered, tt.expected) {
+				t.Errorf("expected %q to be rendered with %q", tt.rendered, tt.expected)
+			}
+		})
+	}
+
+	if strings.Contains(theme.H1.Render("Gotham"), "\x1b[") {
+		t.Errorf("expected the default renderer to be left untouched")
+	}
+}
+
+func TestNewTokens(t *testing.T) {
+	t.Parallel()
+	tk := theme.NewTokens(gotham)
+
+	tests := []struct {
+		name     string
+		token    lipgloss.AdaptiveColor
+		expected lipgloss.AdaptiveColor
+	}{
+		{name: "Primary", token: tk.Primary, expected: lipgloss.AdaptiveColor{Light: "#94a3b8", Dark: "#f1f5f9"}},
+		{name: "Surface", token: tk.Surface, expected: lipgloss.AdaptiveColor{Light: "#f8fafc", Dark: "#334155"}},
+		{name: "Success", token: tk.Success, expected: lipgloss.AdaptiveColor{Light: "#14532d", Dark: "#16a34a"}},
+		{name: "Warning", token: tk.Warning, expected: lipgloss.AdaptiveColor{Light: "#78350f", Dark: "#b45309"}},
+		{name: "Danger", token: tk.Danger, expected: lipgloss.AdaptiveColor{Light: "#7f1d1d", Dark: "#dc2626"}},
+		{name: "Border", token: tk.Border, expected: lipgloss.AdaptiveColor{Light: "#94a3b8", Dark: "#f1f5f9"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.token != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, tt.token)
+			}
+		})
+	}
+}
+
+func TestTokensDeriveStyles(t *testing.T) {
+	t.Parallel()
+	th := theme.Default()
+
+	if th.A.GetForeground() != theme.CompleteAdaptive(theme.Link) {
+		t.Errorf("expected A to use the link token")
+	}
+
+	if th.Mark.GetBackground() != theme.CompleteAdaptive(theme.Surface) {
+		t.Errorf("expected Mark to use the surface token")
+	}
+
+	if th.Logging.Key.GetForeground() != theme.CompleteAdaptive(theme.Primary) {
+		t.Errorf("expected logging keys to use the primary token")
+	}
+
+	if th.BorderStyle.GetForeground() != theme.CompleteAdaptive(theme.Border) {
+		t.Errorf("expected table borders to use the border token")
+	}
+}
+
+func TestAccentScales(t *testing.T) {
+	t.Parallel()
+	scales := map[string][]lipgloss.Color{
+		"Green": {
+			theme.Green50, theme.Green100, theme.Green200, theme.Green300, theme.Green400, theme.Green500,
+			theme.Green600, theme.Green700, theme.Green800, theme.Green900, theme.Green950,
+		},
+		"Amber": {
+			theme.Amber50, theme.Amber100, theme.Amber200, theme.Amber300, theme.Amber400, theme.Amber500,
+			theme.Amber600, theme.Amber700, theme.Amber800, theme.Amber900, theme.Amber950,
+		},
+		"Red": {
+			theme.Red50, theme.Red100, theme.Red200, theme.Red300, theme.Red400, theme.Red500,
+			theme.Red600, theme.Red700, theme.Red800, theme.Red900, theme.Red950,
+		},
+		"Blue": {
+			theme.Blue50, theme.Blue100, theme.Blue200, theme.Blue300, theme.Blue400, theme.Blue500,
+			theme.Blue600, theme.Blue700, theme.Blue800, theme.Blue900, theme.Blue950,
+		},
+	}
+
+	for name, scale := range scales {
+		// Each shade must be darker than the last, from 50 (lightest) to 950 (darkest)
+		for i := 1; i < len(scale); i++ {
+			if luminance(scale[i]) >= luminance(scale[i-1]) {
+				t.Errorf("%s: expected %s to be darker than %s", name, scale[i], scale[i-1])
+			}
+		}
+	}
+}
+
+func luminance(c lipgloss.Color) float64 {
+	var r, g, b int
+	fmt.Sscanf(string(c), "#%02x%02x%02x", &r, &g, &b)
+	return 0.2126*float64(r) + 0.7152*float64(g) + 0.0722*float64(b)
+}
@@ -0,0 +1,77 @@
+package theme
+
+import "github.com/charmbracelet/lipgloss"
+
+// Tokens defines a set of semantic colors that map the shades of a [Palette] to
+// their intended use. Each token supports both light and dark terminals, removing
+// the need to pick between shades by hand
+type Tokens struct {
+	// Primary defines the color for prominent text, such as keys and titles
+	Primary lipgloss.AdaptiveColor
+
+	// Secondary defines the color for supporting text that accompanies [Tokens.Primary]
+	Secondary lipgloss.AdaptiveColor
+
+	// Surface defines the background color for highlighted or raised content
+	Surface lipgloss.AdaptiveColor
+
+	// Muted defines the color for text of lesser importance
+	Muted lipgloss.AdaptiveColor
+
+	// Success defines the color for reporting a successful outcome
+	Success lipgloss.AdaptiveColor
+
+	// Warning defines the color for reporting a potential problem
+	Warning lipgloss.AdaptiveColor
+
+	// Danger defines the color for reporting an error or destructive action
+	Danger lipgloss.AdaptiveColor
+
+	// Info defines the color for reporting neutral information
+	Info lipgloss.AdaptiveColor
+
+	// Border defines the color for borders and dividers
+	Border lipgloss.AdaptiveColor
+
+	// Link defines the color for hyperlinks
+	Link lipgloss.AdaptiveColor
+}
+
+// NewTokens maps the shades of a palette to a set of semantic colors. On a dark
+// terminal, Success, Danger, Info and Border use lighter shades to meet WCAG AA,
+// see [Theme.Audit]
+func NewTokens(p Palette) Tokens {
+	return Tokens{
+		Primary:   adaptive(p.S400, p.S100),
+		Secondary: adaptive(p.S200, p.S50),
+		Surface:   adaptive(p.S50, p.S700),
+		Muted:     adaptive(p.S100, p.S300),
+		Success:   adaptive(p.Green900, p.Green600),
+		Warning:   adaptive(p.Amber900, p.Amber700),
+		Danger:    adaptive(p.Red900, p.Red600),
+		Info:      adaptive(p.Blue900, p.Blue600),
+		Border:    adaptive(p.S400, p.S100),
+		Link:      adaptive(p.S400, p.S100),
+	}
+}
+
+func adaptive(light, dark lipgloss.Color) lipgloss.AdaptiveColor {
+	return lipgloss.AdaptiveColor{
+		Light: string(light),
+		Dark:  string(dark),
+	}
+}
+
+// Defines the semantic colors of the PurpleClay palette
+var (
+	Primary   = def.Tokens.Primary
+	Secondary = def.Tokens.Secondary
+	Surface   = def.Tokens.Surface
+	Muted     = def.Tokens.Muted
+	Success   = def.Tokens.Success
+	Warning   = def.Tokens.Warning
+	Danger    = def.Tokens.Danger
+	Info      = def.Tokens.Info
+	Border    = def.Tokens.Border
+	Link      = def.Tokens.Link
+)
@@ -0,0 +1,169 @@
+package theme
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// treeRow defines a row that is rendered within a tree, along with the
+// guides that connect it to its parent
+type treeRow struct {
+	row   int
+	guide string
+	cont  string
+}
+
+// Parent nests a row of the table beneath a parent row, turning the table into
+// a tree. Rows are rendered beneath their parent, in the order they appear within
+// the table, with the first column indented by a series of tree guides. A parent
+// of -1 returns the row to the root of the tree. Any row without a parent is
+// treated as a root. A row cannot be nested beneath any of its own descendants
+//
+//	theme.NewTable(data).
+//		Parent(2, 1).
+//		Parent(3, 1)
+func (t *Table) Parent(row, parent int) *Table {
+	if !t.checkRow("parent", row) {
+		return t
+	}
+
+	option := fmt.Sprintf("parent: row %d", row)
+	if parent >= len(t.data) || row == parent {
+		t.invalid(option, "expected a parent between -1 and %d, excluding itself, got %d", len(t.data)-1, parent)
+		return t
+	}
+
+	if t.parents == nil {
+		t.parents = make([]int, len(t.data))
+		for i := range t.parents {
+			t.parents[i] = -1
+		}
+	}
+
+	// A row cannot be nested beneath any of its own descendants, as the rows
+	// within the cycle would never be reached from a root of the tree
+	for p := parent; p >= 0; p = t.parents[p] {
+		if p == row {
+			t.invalid(option, "cannot be nested beneath its descendant %d", parent)
+			return t
+		}
+	}
+	t.parents[row] = max(parent, -1)
+
+	t.maxDimensions()
+	return t
+}
+
+// Fold controls whether all children of a row should be hidden from the tree
+func (t *Table) Fold(row int, on bool) *Table {
+	if !t.checkRow("fold", row) {
+		return t
+	}
+
+	if t.folded == nil {
+		t.folded = map[int]bool{}
+	}
+	t.folded[row] = on
+	return t
+}
+
+// FoldMarkers controls whether a marker should be rendered against each parent
+// row within the tree, indicating if its children are expanded or folded
+func (t *Table) FoldMarkers(on bool) *Table {
+	t.markers = on
+	t.maxDimensions()
+	return t
+}
+
+// treeRows returns all rows of the table in the order they should be rendered.
+// Children are rendered beneath their parent, unless it has been folded
+func (t *Table) treeRows() []treeRow {
+	if t.parents == nil {
+		rows := make([]treeRow, 0, len(t.data))
+		for i := range t.data {
+			rows = append(rows, treeRow{row: i})
+		}
+		return rows
+	}
+
+	children := make(map[int][]int, len(t.data))
+	for i, p := range t.parents {
+		children[p] = append(children[p], i)
+	}
+
+	visited := make(map[int]bool, len(t.data))
+	rows := make([]treeRow, 0, len(t.data))
+
+	var walk func(parent int, prefix string)
+	walk = func(parent int, prefix string) {
+		kids := children[parent]
+		for k, row := range kids {
+			if visited[row] {
+				continue
+			}
+			visited[row] = true
+
+			guide, cont := "", ""
+			if parent != -1 {
+				guide = prefix + glyph("├─ ", "|- ")
+				cont = prefix + glyph("│  ", "|  ")
+				if k == len(kids)-1 {
+					guide = prefix + glyph("└─ ", "`- ")
+					cont = prefix + "   "
+				}
+			}
+
+			rows = append(rows, treeRow{row: row, guide: guide + t.marker(row, children), cont: cont})
+			if !t.folded[row] {
+				walk(row, cont)
+			}
+		}
+	}
+	walk(-1, "")
+	return rows
+}
+
+func (t *Table) marker(row int, children map[int][]int) string {
+	if !t.markers {
+		return ""
+	}
+
+	if len(children[row]) == 0 {
+		return "  "
+	}
+
+	if t.folded[row] {
+		return glyph("▸ ", "+ ")
+	}
+	return glyph("▾ ", "- ")
+}
+
+func (t *Table) treeCol() int {
+	if len(t.order) == 0 {
+		return 0
+	}
+	return t.order[0]
+}
+
+// withGuides renders the content of a cell within the tree column, prefixing
+// it with its tree guides. Any wrapped lines are aligned beneath the content
+func (t *Table) withGuides(content string, w int, r treeRow) string {
+	if r.guide == "" {
+		return content
+	}
+
+	guideW := lipgloss.Width(r.guide)
+	content = t.theme.renderer.NewStyle().Width(max(w-guideW, 1)).Render(content)
+
+	guides := []string{r.guide}
+	for i := 1; i < lipgloss.Height(content); i++ {
+		guides = append(guides, r.cont)
+	}
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		t.theme.BorderStyle.Width(guideW).Render(lipgloss.JoinVertical(lipgloss.Left, guides...)),
+		content,
+	)
+}
@@ -0,0 +1,133 @@
+package theme
+
+import (
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rivo/uniseg"
+)
+
+var hasUnicode = unicodeFlag(DetectUnicode())
+
+// widthMu guards changes to the ambiguous width shared with [uniseg]
+var widthMu sync.Mutex
+
+func unicodeFlag(on bool) *atomic.Bool {
+	var b atomic.Bool
+	b.Store(on)
+	return &b
+}
+
+// DetectUnicode inspects the environment to determine whether the terminal is
+// capable of rendering unicode box-drawing and symbol characters. A terminal is
+// assumed to support unicode unless there is evidence to the contrary, such as a
+// non UTF-8 locale (LANG=C), a dumb or linux console (TERM=dumb) or a legacy
+// windows console
+func DetectUnicode() bool {
+	switch os.Getenv("TERM") {
+	case "dumb", "linux":
+		return false
+	}
+
+	if runtime.GOOS == "windows" && !modernWindowsTerminal() {
+		return false
+	}
+
+	// Follow POSIX precedence when resolving the locale of the terminal
+	for _, env := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale := os.Getenv(env); locale != "" {
+			return utf8Locale(locale)
+		}
+	}
+	return true
+}
+
+func modernWindowsTerminal() bool {
+	return os.Getenv("WT_SESSION") != "" ||
+		os.Getenv("TERM_PROGRAM") != "" ||
+		os.Getenv("TERM") != "" ||
+		strings.EqualFold(os.Getenv("ConEmuANSI"), "ON")
+}
+
+func utf8Locale(locale string) bool {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "-", ""))
+	return strings.Contains(locale, "utf8")
+}
+
+// UnicodeSupported reports whether the theme will render unicode box-drawing
+// and symbol characters. When not supported, all glyphs and table borders will
+// transparently be downgraded to their ASCII equivalents
+func UnicodeSupported() bool {
+	return hasUnicode.Load()
+}
+
+// SetUnicodeSupported overrides the automatic detection of unicode support within
+// the terminal. Table borders are resolved each time a table is rendered, so any
+// existing table will adopt the change. The returned function restores the previous
+// setting
+//
+//	defer theme.SetUnicodeSupported(false)()
+func SetUnicodeSupported(on bool) (restore func()) {
+	prev := hasUnicode.Swap(on)
+	resetGlyphs()
+	return func() {
+		hasUnicode.Store(prev)
+		resetGlyphs()
+	}
+}
+
+// SetAmbiguousWidth sets the number of cells occupied by characters with an
+// ambiguous East Asian width, such as ±, Ω or ①. Terminals configured with a CJK
+// locale typically render these characters as wide (2 cells), while all other
+// terminals render them as narrow (1 cell). Any other width will be treated as
+// narrow. Box-drawing characters are themselves ambiguous, so table borders will
+// be downgraded to ASCII when rendering them as wide. As width calculations are
+// shared with [lipgloss] through [uniseg], this applies to all rendering within
+// the process. The returned function restores the previous width, ensuring the
+// setting does not leak into other packages once rendering is complete
+//
+//	defer theme.SetAmbiguousWidth(2)()
+//
+// [lipgloss]: https://github.com/charmbracelet/lipgloss
+// [uniseg]: https://github.com/rivo/uniseg
+func SetAmbiguousWidth(w int) (restore func()) {
+	if w != 2 {
+		w = 1
+	}
+
+	widthMu.Lock()
+	defer widthMu.Unlock()
+	prev := uniseg.EastAsianAmbiguousWidth
+	uniseg.EastAsianAmbiguousWidth = w
+
+	return func() {
+		widthMu.Lock()
+		defer widthMu.Unlock()
+		uniseg.EastAsianAmbiguousWidth = prev
+	}
+}
+
+func ambiguousWide() bool {
+	widthMu.Lock()
+	defer widthMu.Unlock()
+	return uniseg.EastAsianAmbiguousWidth == 2
+}
+
+func glyph(str, fallback string) string {
+	if !hasUnicode.Load() {
+		return fallback
+	}
+	return str
+}
+
+func ascii(str, fallback string) string {
+	for _, r := range str {
+		if r > 127 {
+			return fallback
+		}
+	}
+	return str
+}
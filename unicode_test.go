@@ -0,0 +1,57 @@
+package theme_test
+
+import (
+	"testing"
+
+	theme "github.com/purpleclay/lipgloss-theme"
+)
+
+func TestDetectUnicode(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected bool
+	}{
+		{
+			name:     "NoLocale",
+			env:      map[string]string{},
+			expected: true,
+		},
+		{
+			name:     "UTF8Locale",
+			env:      map[string]string{"LANG": "en_GB.UTF-8"},
+			expected: true,
+		},
+		{
+			name:     "CLocale",
+			env:      map[string]string{"LANG": "C"},
+			expected: false,
+		},
+		{
+			name:     "LCAllTakesPrecedence",
+			env:      map[string]string{"LC_ALL": "POSIX", "LANG": "en_US.utf8"},
+			expected: false,
+		},
+		{
+			name:     "DumbTerminal",
+			env:      map[string]string{"TERM": "dumb", "LANG": "en_US.UTF-8"},
+			expected: false,
+		},
+		{
+			name:     "LinuxConsole",
+			env:      map[string]string{"TERM": "linux"},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, env := range []string{"LC_ALL", "LC_CTYPE", "LANG", "TERM"} {
+				t.Setenv(env, tt.env[env])
+			}
+
+			if got := theme.DetectUnicode(); got != tt.expected {
+				t.Errorf("expected unicode support to be %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
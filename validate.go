@@ -0,0 +1,177 @@
+package theme
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Validate reports any problems with the data or configuration of the table,
+// that would otherwise be silently ignored or result in broken output when
+// rendered. All problems are reported together
+func (t *Table) Validate() error {
+	var errs []error
+
+	switch {
+	case len(t.data) == 0:
+		errs = append(errs, errors.New("data: expected at least one row, got 0"))
+	case len(t.data[0]) == 0:
+		errs = append(errs, errors.New("data: row 0: expected at least one column, got 0"))
+	default:
+		for i, row := range t.data[1:] {
+			if len(row) != len(t.data[0]) {
+				errs = append(errs, fmt.Errorf("data: row %d: expected %d columns, got %d", i+1, len(t.data[0]), len(row)))
+			}
+		}
+		errs = append(errs, t.checkRoom()...)
+		errs = append(errs, t.checkRecords()...)
+		errs = append(errs, t.checkOverflow()...)
+	}
+
+	for _, e := range t.errs {
+		errs = append(errs, e.err)
+	}
+	return errors.Join(errs...)
+}
+
+// Render validates the table before rendering it as a formatted string. Unlike
+// [Table.String], any problems with the table are returned as an error
+func (t *Table) Render() (string, error) {
+	if err := t.Validate(); err != nil {
+		return "", err
+	}
+	return t.String(), nil
+}
+
+// optionErr defines a problem recorded against an option of the table
+type optionErr struct {
+	option string
+	err    error
+}
+
+// invalid records a problem against an option of the table, prefixing it with
+// the name of the option
+func (t *Table) invalid(option, format string, args ...any) {
+	t.errs = append(t.errs, optionErr{
+		option: option,
+		err:    fmt.Errorf(option+": "+format, args...),
+	})
+}
+
+// resetErrors discards any problems previously recorded against an option, as
+// setting an option again replaces its existing configuration
+func (t *Table) resetErrors(option string) {
+	t.errs = slices.DeleteFunc(t.errs, func(e optionErr) bool {
+		return e.option == option
+	})
+}
+
+func (t *Table) cols() int {
+	if len(t.data) == 0 {
+		return 0
+	}
+	return len(t.data[0])
+}
+
+// checkColumns records a problem if more values have been provided to an
+// option than there are columns within the table
+func (t *Table) checkColumns(option string, n int) {
+	if n > 1 && n > t.cols() {
+		t.invalid(option, "expected at most %d columns, got %d", t.cols(), n)
+	}
+}
+
+// checkColumn records a problem if a column is outside of the table. Problems
+// are recorded against the option and column, replacing any previously recorded
+// for the same column
+func (t *Table) checkColumn(option string, col int) bool {
+	option = fmt.Sprintf("%s: column %d", option, col)
+	t.resetErrors(option)
+	if col < 0 || col >= t.cols() {
+		t.invalid(option, "expected a column between 0 and %d", t.cols()-1)
+		return false
+	}
+	return true
+}
+
+// checkRow records a problem if a row is outside of the table. Problems are
+// recorded against the option and row, replacing any previously recorded for
+// the same row
+func (t *Table) checkRow(option string, row int) bool {
+	option = fmt.Sprintf("%s: row %d", option, row)
+	t.resetErrors(option)
+	if row < 0 || row >= len(t.data) {
+		t.invalid(option, "expected a row between 0 and %d", len(t.data)-1)
+		return false
+	}
+	return true
+}
+
+func (t *Table) checkWidths(w []ColumnWidth) {
+	t.checkColumns("widths", len(w))
+	for i, cw := range w {
+		switch {
+		case cw.kind == fixedWidth && cw.value <= 0:
+			t.invalid("widths", "column %d: expected a width greater than 0, got %d", i, cw.value)
+		case cw.kind == percentWidth && (cw.value <= 0 || cw.value > 100):
+			t.invalid("widths", "column %d: expected a percentage between 1 and 100, got %d", i, cw.value)
+		case cw.min < 0:
+			t.invalid("widths", "column %d: expected a minimum width of at least 0, got %d", i, cw.min)
+		}
+	}
+}
+
+// checkRoom reports any fixed width columns that leave no room for content once
+// their horizontal padding has been applied. As widths and padding can be set in
+// any order, this can only be checked once the table has been configured
+func (t *Table) checkRoom() []error {
+	var errs []error
+	for i, cw := range expandWidths(t.widthSpecs, t.cols()) {
+		if cw.kind != fixedWidth || cw.value <= 0 {
+			continue
+		}
+
+		if pad := t.cellStyle(i).GetHorizontalPadding(); cw.value <= pad {
+			errs = append(errs, fmt.Errorf("widths: column %d: expected a width greater than its horizontal padding of %d, got %d", i, pad, cw.value))
+		}
+	}
+	return errs
+}
+
+// checkOverflow reports a table that cannot fit within its total width, even
+// after shrinking any columns sized around their content
+func (t *Table) checkOverflow() []error {
+	if t.records || t.totalWidth <= 0 {
+		return nil
+	}
+
+	if w := t.renderedWidth(); w > t.totalWidth {
+		return []error{fmt.Errorf("total width: expected the table to fit within %d, got %d", t.totalWidth, w)}
+	}
+	return nil
+}
+
+// checkRecords reports any options that have no effect when the table is
+// rendered as a series of records
+func (t *Table) checkRecords() []error {
+	if !t.records {
+		return nil
+	}
+
+	if slices.ContainsFunc(t.priorities, func(p int) bool { return p != 0 }) {
+		return []error{errors.New("priorities: expected no column priorities when rendering records, as every column is rendered")}
+	}
+	return nil
+}
+
+func (t *Table) checkPadding(option string, p []int) {
+	if len(p) > 4 {
+		t.invalid(option, "expected between 1 and 4 values, got %d", len(p))
+	}
+
+	for _, v := range p {
+		if v < 0 {
+			t.invalid(option, "expected padding of at least 0, got %d", v)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package theme_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	theme "github.com/purpleclay/lipgloss-theme"
+)
+
+func TestTableValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		tbl      *theme.Table
+		expected string
+	}{
+		{
+			name:     "NoRows",
+			tbl:      theme.NewTable([][]string{}),
+			expected: "data: expected at least one row, got 0",
+		},
+		{
+			name:     "NoColumns",
+			tbl:      theme.NewTable([][]string{{}}),
+			expected: "data: row 0: expected at least one column, got 0",
+		},
+		{
+			name:     "RaggedRow",
+			tbl:      theme.NewTable([][]string{{"a", "b"}, {"c"}}),
+			expected: "data: row 1: expected 2 columns, got 1",
+		},
+		{
+			name:     "ZeroWidth",
+			tbl:      theme.NewTable(data).Widths(10, 0),
+			expected: "widths: column 1: expected a width greater than 0, got 0",
+		},
+		{
+			name:     "NegativeWidth",
+			tbl:      theme.NewTable(data).Widths(-4),
+			expected: "widths: column 0: expected a width greater than 0, got -4",
+		},
+		{
+			name:     "TooManyWidths",
+			tbl:      theme.NewTable(data).Widths(10, 10, 10, 10, 10),
+			expected: "widths: expected at most 4 columns, got 5",
+		},
+		{
+			name:     "WidthWithinPadding",
+			tbl:      theme.NewTable(data).Widths(1),
+			expected: "widths: column 0: expected a width greater than its horizontal padding of 2, got 1",
+		},
+		{
+			name:     "WidthWithinColumnPadding",
+			tbl:      theme.NewTable(data).Widths(8).ColumnPadding(2, 0, 4),
+			expected: "widths: column 2: expected a width greater than its horizontal padding of 8, got 8",
+		},
+		{
+			name:     "PercentOutOfRange",
+			tbl:      theme.NewTable(data).ColumnWidths(theme.PercentWidth(120)),
+			expected: "widths: column 0: expected a percentage between 1 and 100, got 120",
+		},
+		{
+			name: "TooManyAlignments",
+			tbl: theme.NewTable(data).
+				HorizontalAlignments(lipgloss.Left, lipgloss.Left, lipgloss.Left, lipgloss.Left, lipgloss.Right),
+			expected: "horizontal alignments: expected at most 4 columns, got 5",
+		},
+		{
+			name:     "ColumnPaddingOutOfRange",
+			tbl:      theme.NewTable(data).ColumnPadding(6, 1),
+			expected: "column padding: column 6: expected a column between 0 and 3",
+		},
+		{
+			name:     "TotalWidthOverflow",
+			tbl:      theme.NewTable(data).Border(theme.ThinBorder).Padding(0, 4).TotalWidth(30),
+			expected: "total width: expected the table to fit within 30, got 41",
+		},
+		{
+			name:     "RecordPriorities",
+			tbl:      theme.NewTable(data).Priorities(1, 0, 2).Records(true),
+			expected: "priorities: expected no column priorities when rendering records",
+		},
+		{
+			name:     "UnknownOrderKey",
+			tbl:      theme.NewTable(data).Order("Alias"),
+			expected: `order: unknown column key "Alias"`,
+		},
+		{
+			name:     "NestOutOfRange",
+			tbl:      theme.NewTable(data).Nest(10, 0, theme.NewTable(data)),
+			expected: "nest: row 10: expected a row between 0 and 6",
+		},
+		{
+			name:     "ParentCycle",
+			tbl:      theme.NewTable(data).Parent(1, 2).Parent(3, 1).Parent(2, 3),
+			expected: "parent: row 2: cannot be nested beneath its descendant 3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tbl.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestTableValidateCorrected(t *testing.T) {
+	tests := []struct {
+		name string
+		tbl  *theme.Table
+	}{
+		{
+			name: "Widths",
+			tbl:  theme.NewTable(data).Widths(0).Widths(5),
+		},
+		{
+			name: "TotalWidth",
+			tbl:  theme.NewTable(data).TotalWidth(-1).TotalWidth(80),
+		},
+		{
+			name: "WidthWithinPadding",
+			tbl:  theme.NewTable(data).Widths(2).Padding(0),
+		},
+		{
+			name: "WidthWithinPaddingCollapsed",
+			tbl:  theme.NewTable(data).Widths(1).Collapsed(true),
+		},
+		{
+			name: "Padding",
+			tbl:  theme.NewTable(data).Padding(-1).Padding(1),
+		},
+		{
+			name: "ColumnPadding",
+			tbl:  theme.NewTable(data).ColumnPadding(1, -1).ColumnPadding(1, 1),
+		},
+		{
+			name: "RowSpacing",
+			tbl:  theme.NewTable(data).RowSpacing(-1).RowSpacing(1),
+		},
+		{
+			name: "Order",
+			tbl:  theme.NewTable(data).Order("Alias").Order("Name"),
+		},
+		{
+			name: "Parent",
+			tbl:  theme.NewTable(data).Parent(1, 1).Parent(1, 2),
+		},
+		{
+			name: "ParentOutOfRange",
+			tbl:  theme.NewTable(data).Parent(7, 1).AppendRow(data[1]).Parent(7, 1),
+		},
+		{
+			name: "ColumnPaddingOutOfRange",
+			tbl:  theme.NewTable([][]string{{"a"}}).ColumnPadding(1, 1).AppendColumn("b").ColumnPadding(1, 1),
+		},
+		{
+			name: "InsertRow",
+			tbl:  theme.NewTable(data).InsertRow(8, data[1]).AppendRow(data[1]).InsertRow(8, data[1]),
+		},
+		{
+			name: "Nest",
+			tbl:  theme.NewTable(data).Nest(7, 0, theme.NewTable(data)).AppendRow(data[1]).Nest(7, 0, theme.NewTable(data)),
+		},
+		{
+			name: "Fold",
+			tbl:  theme.NewTable(data).Fold(7, true).AppendRow(data[1]).Fold(7, true),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.tbl.Validate(); err != nil {
+				t.Errorf("expected a corrected option to be valid, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTableValidateColumnPaddingPerColumn(t *testing.T) {
+	err := theme.NewTable(data).ColumnPadding(1, -1).ColumnPadding(2, 1).Validate()
+
+	expected := "column padding: column 1: expected padding of at least 0, got -1"
+	if err == nil || !strings.Contains(err.Error(), expected) {
+		t.Errorf("expected error containing %q, got %v", expected, err)
+	}
+}
+
+func TestTableRender(t *testing.T) {
+	t.Parallel()
+	out, err := theme.NewTable(data).Border(theme.ThinBorder).Render()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if expected := theme.NewTable(data).Border(theme.ThinBorder).String(); out != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, out)
+	}
+}
+
+func TestTableStringWithoutData(t *testing.T) {
+	tests := []struct {
+		name string
+		tbl  *theme.Table
+	}{
+		{
+			name: "NoRows",
+			tbl:  theme.NewTable([][]string{}),
+		},
+		{
+			name: "NoColumns",
+			tbl:  theme.NewTable([][]string{{}}),
+		},
+		{
+			name: "NoColumnsRowNumbers",
+			tbl:  theme.NewTable([][]string{{}}).RowNumbers(1, "#").TotalWidth(20),
+		},
+		{
+			name: "NoColumnsRecords",
+			tbl:  theme.NewTable([][]string{{}}).Records(true),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if out := tt.tbl.String(); out != "" {
+				t.Errorf("expected no output, got:\n%s", out)
+			}
+		})
+	}
+}
+
+func TestTableRenderInvalid(t *testing.T) {
+	t.Parallel()
+	tbl := theme.NewTable([][]string{{"a", "b"}, {"c"}, {"d", "e", "f"}})
+
+	// Rendering without validation should still be possible
+	_ = tbl.String()
+
+	out, err := tbl.Render()
+	if err == nil {
+		t.Fatal("expected an error for a ragged row")
+	}
+
+	if out != "" {
+		t.Errorf("expected no output, got:\n%s", out)
+	}
+}
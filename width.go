@@ -0,0 +1,137 @@
+package theme
+
+type widthKind int
+
+const (
+	autoWidth widthKind = iota
+	fixedWidth
+	percentWidth
+	flexWidth
+)
+
+// ColumnWidth defines how the width of a table column is resolved against
+// the total width of the table
+type ColumnWidth struct {
+	kind  widthKind
+	value int
+	min   int
+}
+
+// AutoWidth sizes a column around its content. This is the default width
+// of a column
+func AutoWidth() ColumnWidth {
+	return ColumnWidth{kind: autoWidth}
+}
+
+// FixedWidth fixes the width of a column, irrespective of its content
+func FixedWidth(w int) ColumnWidth {
+	return ColumnWidth{kind: fixedWidth, value: w}
+}
+
+// PercentWidth sizes a column as a percentage of the total width available
+// to all columns within the table, after any borders have been accounted for
+func PercentWidth(p int) ColumnWidth {
+	return ColumnWidth{kind: percentWidth, value: p}
+}
+
+// FlexWidth sizes a column by sharing any remaining width of the table with all
+// other flexible columns, based on its weight. A column with a weight of 2 will
+// be given twice as much width as a column with a weight of 1, much like the
+// CSS fr unit. If no total width has been set, the column is sized around its
+// content
+func FlexWidth(weight int) ColumnWidth {
+	return ColumnWidth{kind: flexWidth, value: max(weight, 1)}
+}
+
+// Min sets the minimum width of the column, ensuring it will never be resolved
+// to anything smaller
+func (c ColumnWidth) Min(w int) ColumnWidth {
+	c.min = w
+	return c
+}
+
+func (c ColumnWidth) clamp(w int) int {
+	return max(w, c.min, 1)
+}
+
+// expandWidths expands the width specifications to cover n columns. A single
+// specification is applied to all columns, while any missing specifications
+// default to sizing a column around its content
+func expandWidths(specs []ColumnWidth, n int) []ColumnWidth {
+	colSpecs := make([]ColumnWidth, n)
+	if len(specs) == 1 {
+		for i := range colSpecs {
+			colSpecs[i] = specs[0]
+		}
+		return colSpecs
+	}
+
+	copy(colSpecs, specs)
+	return colSpecs
+}
+
+// resolveWidths calculates the width of each column from its specification. The
+// available width is the total width of the table, minus any borders. Flexible
+// columns share any width remaining after all other columns have been resolved
+func resolveWidths(specs []ColumnWidth, natural []int, available int) []int {
+	widths := make([]int, len(natural))
+	if len(natural) == 0 {
+		return widths
+	}
+
+	colSpecs := expandWidths(specs, len(natural))
+
+	flexible := available > 0
+	if !flexible {
+		for _, w := range natural {
+			available += w
+		}
+	}
+
+	used := 0
+	weights := 0
+	for i, spec := range colSpecs {
+		switch spec.kind {
+		case fixedWidth:
+			widths[i] = spec.clamp(spec.value)
+		case percentWidth:
+			widths[i] = spec.clamp(available * spec.value / 100)
+		case flexWidth:
+			if flexible {
+				weights += spec.value
+				continue
+			}
+			widths[i] = spec.clamp(natural[i])
+		default:
+			widths[i] = spec.clamp(natural[i])
+		}
+		used += widths[i]
+	}
+
+	if weights == 0 {
+		return widths
+	}
+
+	remaining := max(available-used, 0)
+	lastFlex := 0
+	shared := 0
+	for i, spec := range colSpecs {
+		if spec.kind != flexWidth {
+			continue
+		}
+
+		widths[i] = remaining * spec.value / weights
+		shared += widths[i]
+		lastFlex = i
+	}
+
+	// Any width lost through integer division is given to the last flexible column
+	widths[lastFlex] += remaining - shared
+
+	for i, spec := range colSpecs {
+		if spec.kind == flexWidth {
+			widths[i] = spec.clamp(widths[i])
+		}
+	}
+	return widths
+}